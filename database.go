@@ -3,9 +3,13 @@ package goqu
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/sqlgen"
 )
 
 type (
@@ -22,10 +26,15 @@ type (
 		QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
 		QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 	}
+	// used internally by datasets that need to execute multiple statements inside of a single transaction
+	txRunner interface {
+		runInTx(ctx context.Context, fn func(tx *TxDatabase) error) error
+	}
 	// This struct is the wrapper for a Db. The struct delegates most calls to either an Exec instance or to the Db
 	// passed into the constructor.
 	Database struct {
 		logger  Logger
+		hooks   queryHooks
 		dialect string
 		//nolint:stylecheck // keep for backwards compatibility
 		Db     SQLDatabase
@@ -88,6 +97,7 @@ func (d *Database) Begin() (*TxDatabase, error) {
 	}
 	tx := NewTx(d.dialect, sqlTx)
 	tx.Logger(d.logger)
+	tx.hooks = append(queryHooks(nil), d.hooks...)
 	return tx, nil
 }
 
@@ -99,6 +109,7 @@ func (d *Database) BeginTx(ctx context.Context, opts *sql.TxOptions) (*TxDatabas
 	}
 	tx := NewTx(d.dialect, sqlTx)
 	tx.Logger(d.logger)
+	tx.hooks = append(queryHooks(nil), d.hooks...)
 	return tx, nil
 }
 
@@ -111,6 +122,15 @@ func (d *Database) WithTx(fn func(*TxDatabase) error) error {
 	return tx.Wrap(func() error { return fn(tx) })
 }
 
+// used internally to execute fn inside of a new transaction that is committed if fn succeeds
+func (d *Database) runInTx(ctx context.Context, fn func(tx *TxDatabase) error) error {
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return tx.Wrap(func() error { return fn(tx) })
+}
+
 // Creates a new Dataset that uses the correct adapter and supports queries.
 //
 //	var ids []uint32
@@ -133,7 +153,9 @@ func (d *Database) Update(table interface{}) *UpdateDataset {
 }
 
 func (d *Database) Insert(table interface{}) *InsertDataset {
-	return newInsertDataset(d.dialect, d.queryFactory()).Into(table)
+	id := newInsertDataset(d.dialect, d.queryFactory())
+	id.txRunner = d
+	return id.Into(table)
 }
 
 func (d *Database) Delete(table interface{}) *DeleteDataset {
@@ -144,11 +166,35 @@ func (d *Database) Truncate(table ...interface{}) *TruncateDataset {
 	return newTruncateDataset(d.dialect, d.queryFactory()).Table(table...)
 }
 
+func (d *Database) Merge(target interface{}) *MergeDataset {
+	return newMergeDataset(d.dialect, d.queryFactory()).Into(target)
+}
+
 // Sets the logger for to use when logging queries
 func (d *Database) Logger(logger Logger) {
 	d.logger = logger
 }
 
+// Sets the structured logger to use when logging queries. Each statement is logged with the op, sql, args, duration
+// and error (if one occurred). Statements executed in a transaction also include the tx_id. Transactions started
+// after the logger is set will inherit the logger. Passing a nil logger removes the structured logger.
+//
+//	opts := goqu.DefaultStructuredLoggerOptions()
+//	opts.SlowThreshold = 100 * time.Millisecond
+//	db.StructuredLogger(goqu.NewSlogLogger(slog.Default()), opts)
+//
+// See StructuredLoggerOptions.
+func (d *Database) StructuredLogger(logger StructuredLogger, opts StructuredLoggerOptions) {
+	d.hooks = d.hooks.withQueryLogger(logger, opts)
+}
+
+// Adds a hook that is called around every statement executed by the database, including statements executed by
+// datasets and transactions started after the hook is added. Hooks are called in the order they were added, and should
+// be added before the database is used.
+func (d *Database) AddQueryHook(hook QueryHook) {
+	d.hooks = append(d.hooks, hook)
+}
+
 // Logs a given operation with the specified sql and arguments
 func (d *Database) Trace(op, sqlString string, args ...interface{}) {
 	if d.logger != nil {
@@ -179,8 +225,12 @@ func (d *Database) Exec(query string, args ...interface{}) (sql.Result, error) {
 //
 // args...: for any placeholder parameters in the query
 func (d *Database) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
-	d.Trace("EXEC", query, args...)
-	return d.Db.ExecContext(ctx, query, args...)
+	redacted := exec.RedactSQL(ctx, query, args)
+	d.Trace("EXEC", redacted.Query, redacted.Args...)
+	ctx, after := d.hooks.run(ctx, "EXEC", redacted.Query, redacted.Args)
+	res, err := d.Db.ExecContext(ctx, query, args...)
+	after(res, err)
+	return res, err
 }
 
 // Can be used to prepare a query.
@@ -241,7 +291,10 @@ func (d *Database) Prepare(query string) (*sql.Stmt, error) {
 // query: The SQL statement to prepare.
 func (d *Database) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
 	d.Trace("PREPARE", query)
-	return d.Db.PrepareContext(ctx, query)
+	ctx, after := d.hooks.run(ctx, "PREPARE", query, nil)
+	stmt, err := d.Db.PrepareContext(ctx, query)
+	after(nil, err)
+	return stmt, err
 }
 
 // Used to query for multiple rows.
@@ -295,8 +348,12 @@ func (d *Database) Query(query string, args ...interface{}) (*sql.Rows, error) {
 //
 // args...: for any placeholder parameters in the query
 func (d *Database) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
-	d.Trace("QUERY", query, args...)
-	return d.Db.QueryContext(ctx, query, args...)
+	redacted := exec.RedactSQL(ctx, query, args)
+	d.Trace("QUERY", redacted.Query, redacted.Args...)
+	ctx, after := d.hooks.run(ctx, "QUERY", redacted.Query, redacted.Args)
+	rows, err := d.Db.QueryContext(ctx, query, args...)
+	after(nil, err)
+	return rows, err
 }
 
 // Used to query for a single row.
@@ -338,8 +395,12 @@ func (d *Database) QueryRow(query string, args ...interface{}) *sql.Row {
 //
 // args...: for any placeholder parameters in the query
 func (d *Database) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
-	d.Trace("QUERY ROW", query, args...)
-	return d.Db.QueryRowContext(ctx, query, args...)
+	redacted := exec.RedactSQL(ctx, query, args)
+	d.Trace("QUERY ROW", redacted.Query, redacted.Args...)
+	ctx, after := d.hooks.run(ctx, "QUERY ROW", redacted.Query, redacted.Args)
+	row := d.Db.QueryRowContext(ctx, query, args...)
+	after(nil, row.Err())
+	return row
 }
 
 func (d *Database) queryFactory() exec.QueryFactory {
@@ -459,16 +520,32 @@ type (
 	}
 	TxDatabase struct {
 		logger  Logger
+		hooks   queryHooks
+		id      uint64
 		dialect string
 		Tx      SQLTx
 		qf      exec.QueryFactory
 		qfOnce  sync.Once
+
+		// the number of savepoints created by WithTx, used to generate unique savepoint names
+		savepoints int
 	}
+	txIDCtxKey struct{}
 )
 
+// used to assign each TxDatabase a unique id
+var txIDCounter uint64
+
 // Creates a new TxDatabase
 func NewTx(dialect string, tx SQLTx) *TxDatabase {
-	return &TxDatabase{dialect: dialect, Tx: tx}
+	return &TxDatabase{dialect: dialect, Tx: tx, id: atomic.AddUint64(&txIDCounter, 1)}
+}
+
+// Returns the id of the transaction a statement is being executed in. This can be used by a QueryHook to group the
+// statements executed in a single transaction.
+func TxIDFromContext(ctx context.Context) (uint64, bool) {
+	id, ok := ctx.Value(txIDCtxKey{}).(uint64)
+	return id, ok
 }
 
 // returns this databases dialect
@@ -490,7 +567,9 @@ func (td *TxDatabase) Update(table interface{}) *UpdateDataset {
 }
 
 func (td *TxDatabase) Insert(table interface{}) *InsertDataset {
-	return newInsertDataset(td.dialect, td.queryFactory()).Into(table)
+	id := newInsertDataset(td.dialect, td.queryFactory())
+	id.txRunner = td
+	return id.Into(table)
 }
 
 func (td *TxDatabase) Delete(table interface{}) *DeleteDataset {
@@ -501,11 +580,38 @@ func (td *TxDatabase) Truncate(table ...interface{}) *TruncateDataset {
 	return newTruncateDataset(td.dialect, td.queryFactory()).Table(table...)
 }
 
+func (td *TxDatabase) Merge(target interface{}) *MergeDataset {
+	return newMergeDataset(td.dialect, td.queryFactory()).Into(target)
+}
+
 // Sets the logger
 func (td *TxDatabase) Logger(logger Logger) {
 	td.logger = logger
 }
 
+// Sets the structured logger, see Database#StructuredLogger
+func (td *TxDatabase) StructuredLogger(logger StructuredLogger, opts StructuredLoggerOptions) {
+	td.hooks = td.hooks.withQueryLogger(logger, opts)
+}
+
+// used internally to run the hooks with the id of the transaction set on the context
+func (td *TxDatabase) runHooks(
+	ctx context.Context,
+	op, query string,
+	args []interface{},
+) (hookCtx context.Context, after func(result sql.Result, err error)) {
+	if len(td.hooks) != 0 {
+		ctx = context.WithValue(ctx, txIDCtxKey{}, td.id)
+	}
+	return td.hooks.run(ctx, op, query, args)
+}
+
+// Adds a hook that is called around every statement executed by the transaction. Transactions started from a Database
+// inherit the hooks added to the Database. See Database#AddQueryHook.
+func (td *TxDatabase) AddQueryHook(hook QueryHook) {
+	td.hooks = append(td.hooks, hook)
+}
+
 func (td *TxDatabase) Trace(op, sqlString string, args ...interface{}) {
 	if td.logger != nil {
 		if sqlString != "" {
@@ -527,8 +633,12 @@ func (td *TxDatabase) Exec(query string, args ...interface{}) (sql.Result, error
 
 // See Database#ExecContext
 func (td *TxDatabase) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
-	td.Trace("EXEC", query, args...)
-	return td.Tx.ExecContext(ctx, query, args...)
+	redacted := exec.RedactSQL(ctx, query, args)
+	td.Trace("EXEC", redacted.Query, redacted.Args...)
+	ctx, after := td.runHooks(ctx, "EXEC", redacted.Query, redacted.Args)
+	res, err := td.Tx.ExecContext(ctx, query, args...)
+	after(res, err)
+	return res, err
 }
 
 // See Database#Prepare
@@ -539,7 +649,10 @@ func (td *TxDatabase) Prepare(query string) (*sql.Stmt, error) {
 // See Database#PrepareContext
 func (td *TxDatabase) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
 	td.Trace("PREPARE", query)
-	return td.Tx.PrepareContext(ctx, query)
+	ctx, after := td.runHooks(ctx, "PREPARE", query, nil)
+	stmt, err := td.Tx.PrepareContext(ctx, query)
+	after(nil, err)
+	return stmt, err
 }
 
 // See Database#Query
@@ -549,8 +662,12 @@ func (td *TxDatabase) Query(query string, args ...interface{}) (*sql.Rows, error
 
 // See Database#QueryContext
 func (td *TxDatabase) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
-	td.Trace("QUERY", query, args...)
-	return td.Tx.QueryContext(ctx, query, args...)
+	redacted := exec.RedactSQL(ctx, query, args)
+	td.Trace("QUERY", redacted.Query, redacted.Args...)
+	ctx, after := td.runHooks(ctx, "QUERY", redacted.Query, redacted.Args)
+	rows, err := td.Tx.QueryContext(ctx, query, args...)
+	after(nil, err)
+	return rows, err
 }
 
 // See Database#QueryRow
@@ -560,8 +677,12 @@ func (td *TxDatabase) QueryRow(query string, args ...interface{}) *sql.Row {
 
 // See Database#QueryRowContext
 func (td *TxDatabase) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
-	td.Trace("QUERY ROW", query, args...)
-	return td.Tx.QueryRowContext(ctx, query, args...)
+	redacted := exec.RedactSQL(ctx, query, args)
+	td.Trace("QUERY ROW", redacted.Query, redacted.Args...)
+	ctx, after := td.runHooks(ctx, "QUERY ROW", redacted.Query, redacted.Args)
+	row := td.Tx.QueryRowContext(ctx, query, args...)
+	after(nil, row.Err())
+	return row
 }
 
 func (td *TxDatabase) queryFactory() exec.QueryFactory {
@@ -623,6 +744,11 @@ func (td *TxDatabase) Rollback() error {
 	return td.Tx.Rollback()
 }
 
+// used internally to execute fn inside of this transaction
+func (td *TxDatabase) runInTx(_ context.Context, fn func(tx *TxDatabase) error) error {
+	return fn(td)
+}
+
 // A helper method that will automatically COMMIT or ROLLBACK once the supplied function is done executing
 //
 //	tx, err := db.Begin()
@@ -656,3 +782,118 @@ func (td *TxDatabase) Wrap(fn func() error) (err error) {
 	}()
 	return fn()
 }
+
+// Creates a SAVEPOINT with the name inside of the transaction. The savepoint syntax is dialect specific (e.g. SQL
+// Server uses SAVE TRANSACTION). The name must only contain letters, digits and underscores.
+func (td *TxDatabase) Savepoint(name string) error {
+	return td.SavepointContext(context.Background(), name)
+}
+
+// See TxDatabase#Savepoint
+func (td *TxDatabase) SavepointContext(ctx context.Context, name string) error {
+	return td.execSavepoint(ctx, td.dialectOptions().SavepointFragment, name)
+}
+
+// Releases the SAVEPOINT with the name. If the dialect does not support releasing savepoints (e.g. SQL Server) this is
+// a no-op.
+func (td *TxDatabase) ReleaseSavepoint(name string) error {
+	return td.ReleaseSavepointContext(context.Background(), name)
+}
+
+// See TxDatabase#ReleaseSavepoint
+func (td *TxDatabase) ReleaseSavepointContext(ctx context.Context, name string) error {
+	fragment := td.dialectOptions().ReleaseSavepointFragment
+	if len(fragment) == 0 {
+		return nil
+	}
+	return td.execSavepoint(ctx, fragment, name)
+}
+
+// Rolls back all statements executed since the SAVEPOINT with the name was created, the transaction is still active.
+func (td *TxDatabase) RollbackTo(name string) error {
+	return td.RollbackToContext(context.Background(), name)
+}
+
+// See TxDatabase#RollbackTo
+func (td *TxDatabase) RollbackToContext(ctx context.Context, name string) error {
+	return td.execSavepoint(ctx, td.dialectOptions().RollbackToSavepointFragment, name)
+}
+
+// Executes fn inside of a nested transaction using a SAVEPOINT. If fn returns an error or panics the transaction is
+// rolled back to the savepoint, the savepoint is released once fn returns. If the rollback fails the error returned by
+// fn is wrapped with the rollback error. Unlike Database#WithTx the transaction is not committed, so WithTx can be
+// called any number of times and nested inside of other calls to WithTx.
+//
+//	err := db.WithTx(func(tx *goqu.TxDatabase) error {
+//	    if _, err := tx.Insert("orders").Rows(order).Executor().Exec(); err != nil {
+//	        return err
+//	    }
+//	    // a failed audit insert should not roll back the order
+//	    if err := tx.WithTx(func(tx *goqu.TxDatabase) error {
+//	        _, err := tx.Insert("audit").Rows(audit).Executor().Exec()
+//	        return err
+//	    }); err != nil {
+//	        log.Printf("unable to audit order: %v", err)
+//	    }
+//	    return nil
+//	})
+func (td *TxDatabase) WithTx(fn func(*TxDatabase) error) (err error) {
+	td.savepoints++
+	name := fmt.Sprintf("goqu_savepoint_%d", td.savepoints)
+	if err = td.Savepoint(name); err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = td.RollbackTo(name)
+			panic(p)
+		}
+		if err != nil {
+			if rollbackErr := td.RollbackTo(name); rollbackErr != nil {
+				err = fmt.Errorf("%w: unable to rollback to savepoint %s: %v", err, name, rollbackErr)
+				return
+			}
+		}
+		if releaseErr := td.ReleaseSavepoint(name); releaseErr != nil && err == nil {
+			err = releaseErr
+		}
+	}()
+	return fn(td)
+}
+
+func (td *TxDatabase) dialectOptions() *sqlgen.SQLDialectOptions {
+	return GetDialect(td.dialect).DialectOptions()
+}
+
+// used internally to execute a savepoint statement with the fragment and name
+func (td *TxDatabase) execSavepoint(ctx context.Context, fragment []byte, name string) error {
+	if len(fragment) == 0 {
+		return errSavepointsNotSupported(td.dialect)
+	}
+	if !isValidSavepointName(name) {
+		return errInvalidSavepointName(name)
+	}
+	_, err := td.ExecContext(ctx, string(fragment)+name)
+	return err
+}
+
+func isValidSavepointName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_'
+		if !isLetter && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func errSavepointsNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support savepoints [dialect=%s]", dialect)
+}
+
+func errInvalidSavepointName(name string) error {
+	return errors.New("invalid savepoint name %q, a savepoint name must only contain letters, digits and underscores", name)
+}
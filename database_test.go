@@ -2,13 +2,17 @@ package goqu_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/sqlgen"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -29,6 +33,67 @@ func (dtml *dbTestMockLogger) Reset() {
 	dtml.Messages = dtml.Messages[0:0]
 }
 
+type dbTestHookCtxKey string
+
+type dbTestMockHook struct {
+	name     string
+	Messages *[]string
+}
+
+func (dtmh *dbTestMockHook) BeforeQuery(ctx context.Context, op, query string, args []interface{}) context.Context {
+	*dtmh.Messages = append(*dtmh.Messages, fmt.Sprintf("%s before %s [query:=`%s` args:=%+v]", dtmh.name, op, query, args))
+	return context.WithValue(ctx, dbTestHookCtxKey(dtmh.name), true)
+}
+
+func (dtmh *dbTestMockHook) AfterQuery(
+	ctx context.Context,
+	op, query string,
+	args []interface{},
+	err error,
+	duration time.Duration,
+) {
+	fromBefore := ctx.Value(dbTestHookCtxKey(dtmh.name)) == true
+	*dtmh.Messages = append(*dtmh.Messages, fmt.Sprintf(
+		"%s after %s [ctx:=%t err:=%v duration:=%t]", dtmh.name, op, fromBefore, err, duration >= 0,
+	))
+}
+
+// implements goqu.QueryResultHook to record the rows affected by the statement
+type dbTestMockResultHook struct {
+	dbTestMockHook
+}
+
+func (dtmh *dbTestMockResultHook) AfterQueryResult(
+	ctx context.Context,
+	op, query string,
+	args []interface{},
+	result sql.Result,
+	err error,
+	duration time.Duration,
+) {
+	fromBefore := ctx.Value(dbTestHookCtxKey(dtmh.name)) == true
+	rowsAffected := int64(-1)
+	if result != nil {
+		rowsAffected, _ = result.RowsAffected()
+	}
+	*dtmh.Messages = append(*dtmh.Messages, fmt.Sprintf(
+		"%s after %s [ctx:=%t rows:=%d err:=%v duration:=%t]", dtmh.name, op, fromBefore, rowsAffected, err, duration >= 0,
+	))
+}
+
+type dbTestStructuredLogger struct {
+	Messages []string
+}
+
+func (dtsl *dbTestStructuredLogger) Log(ctx context.Context, level goqu.LogLevel, msg string, args ...interface{}) {
+	for i := 0; i < len(args); i += 2 {
+		if args[i] == "duration" {
+			args[i+1] = args[i+1].(time.Duration) >= 0
+		}
+	}
+	dtsl.Messages = append(dtsl.Messages, fmt.Sprintf("%d %s %v", level, msg, args))
+}
+
 type databaseSuite struct {
 	suite.Suite
 }
@@ -60,6 +125,158 @@ func (ds *databaseSuite) TestLogger() {
 	}, logger.Messages)
 }
 
+func (ds *databaseSuite) TestLogger_Redacted() {
+	type user struct {
+		Name     string `db:"name"`
+		Password string `db:"password" goqu:"sensitive"`
+	}
+	goqu.RegisterSensitiveValueMatcher(func(value interface{}) bool {
+		s, ok := value.(string)
+		return ok && strings.HasPrefix(s, "db_sensitive_test_")
+	})
+	mDB, mock, err := sqlmock.New()
+	ds.NoError(err)
+	mock.ExpectExec(`INSERT INTO "users" \("name", "password"\) VALUES \('Bob', 'secret'\)`).
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "users" SET "name"=\?,"password"=\? WHERE \("token" = \?\)`).
+		WithArgs("Bob", "secret", "db_sensitive_test_token").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM "users" WHERE "token" = \? AND "name" = \?`).
+		WithArgs("db_sensitive_test_token", "Bob").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	var hookMessages []string
+	db := goqu.New("mock", mDB)
+	logger := new(dbTestMockLogger)
+	db.Logger(logger)
+	db.AddQueryHook(&dbTestMockResultHook{dbTestMockHook{name: "hook", Messages: &hookMessages}})
+
+	_, err = db.Insert("users").Rows(user{Name: "Bob", Password: "secret"}).Executor().Exec()
+	ds.NoError(err)
+	_, err = db.Update("users").
+		Prepared(true).
+		Set(user{Name: "Bob", Password: "secret"}).
+		Where(goqu.C("token").Eq("db_sensitive_test_token")).
+		Executor().
+		Exec()
+	ds.NoError(err)
+	_, err = db.Exec(`DELETE FROM "users" WHERE "token" = ? AND "name" = ?`, "db_sensitive_test_token", "Bob")
+	ds.NoError(err)
+
+	ds.Equal([]string{
+		"[goqu] EXEC [query:=`INSERT INTO \"users\" (\"name\", \"password\") VALUES ('Bob', [REDACTED])`]",
+		"[goqu] EXEC [query:=`UPDATE \"users\" SET \"name\"=?,\"password\"=? WHERE (\"token\" = ?)` args:=[Bob [REDACTED] [REDACTED]]]",
+		"[goqu] EXEC [query:=`DELETE FROM \"users\" WHERE \"token\" = ? AND \"name\" = ?` args:=[[REDACTED] Bob]]",
+	}, logger.Messages)
+	ds.Equal([]string{
+		"hook before EXEC [query:=`INSERT INTO \"users\" (\"name\", \"password\") VALUES ('Bob', [REDACTED])` args:=[]]",
+		"hook after EXEC [ctx:=true rows:=1 err:=<nil> duration:=true]",
+		"hook before EXEC [query:=`UPDATE \"users\" SET \"name\"=?,\"password\"=? WHERE (\"token\" = ?)` args:=[Bob [REDACTED] [REDACTED]]]",
+		"hook after EXEC [ctx:=true rows:=1 err:=<nil> duration:=true]",
+		"hook before EXEC [query:=`DELETE FROM \"users\" WHERE \"token\" = ? AND \"name\" = ?` args:=[[REDACTED] Bob]]",
+		"hook after EXEC [ctx:=true rows:=1 err:=<nil> duration:=true]",
+	}, hookMessages)
+	ds.NoError(mock.ExpectationsWereMet())
+}
+
+func (ds *databaseSuite) TestAddQueryHook() {
+	mDB, mock, err := sqlmock.New()
+	ds.NoError(err)
+	mock.ExpectQuery(`SELECT "address", "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).FromCSVString("111 Test Addr,Test1"))
+	mock.ExpectExec(`DELETE FROM "items" WHERE "id" = \?`).
+		WithArgs(1).
+		WillReturnError(errors.New("exec error"))
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1"))
+	mock.ExpectPrepare(`SELECT "name" FROM "items"`)
+
+	var messages []string
+	db := goqu.New("db-mock", mDB)
+	db.AddQueryHook(&dbTestMockHook{name: "first", Messages: &messages})
+	db.AddQueryHook(&dbTestMockResultHook{dbTestMockHook{name: "second", Messages: &messages}})
+
+	var items []testActionItem
+	ds.NoError(db.From("items").ScanStructs(&items))
+	_, err = db.Exec(`DELETE FROM "items" WHERE "id" = ?`, 1)
+	ds.EqualError(err, "goqu: exec error")
+	var name string
+	ds.NoError(db.QueryRow(`SELECT "name" FROM "items"`).Scan(&name))
+	_, err = db.Prepare(`SELECT "name" FROM "items"`)
+	ds.NoError(err)
+
+	ds.Equal([]string{
+		"first before QUERY [query:=`SELECT \"address\", \"name\" FROM \"items\"` args:=[]]",
+		"second before QUERY [query:=`SELECT \"address\", \"name\" FROM \"items\"` args:=[]]",
+		"second after QUERY [ctx:=true rows:=-1 err:=<nil> duration:=true]",
+		"first after QUERY [ctx:=true err:=<nil> duration:=true]",
+		"first before EXEC [query:=`DELETE FROM \"items\" WHERE \"id\" = ?` args:=[1]]",
+		"second before EXEC [query:=`DELETE FROM \"items\" WHERE \"id\" = ?` args:=[1]]",
+		"second after EXEC [ctx:=true rows:=-1 err:=goqu: exec error duration:=true]",
+		"first after EXEC [ctx:=true err:=goqu: exec error duration:=true]",
+		"first before QUERY ROW [query:=`SELECT \"name\" FROM \"items\"` args:=[]]",
+		"second before QUERY ROW [query:=`SELECT \"name\" FROM \"items\"` args:=[]]",
+		"second after QUERY ROW [ctx:=true rows:=-1 err:=<nil> duration:=true]",
+		"first after QUERY ROW [ctx:=true err:=<nil> duration:=true]",
+		"first before PREPARE [query:=`SELECT \"name\" FROM \"items\"` args:=[]]",
+		"second before PREPARE [query:=`SELECT \"name\" FROM \"items\"` args:=[]]",
+		"second after PREPARE [ctx:=true rows:=-1 err:=<nil> duration:=true]",
+		"first after PREPARE [ctx:=true err:=<nil> duration:=true]",
+	}, messages)
+	ds.NoError(mock.ExpectationsWereMet())
+}
+
+func (ds *databaseSuite) TestStructuredLogger() {
+	mDB, mock, err := sqlmock.New()
+	ds.NoError(err)
+	mock.ExpectQuery(`SELECT "name" FROM "items" WHERE \("id" = \$1\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1"))
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillDelayFor(10 * time.Millisecond).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1"))
+	mock.ExpectExec(`DELETE FROM "items" WHERE \("id" = \$1\)`).
+		WithArgs(2).
+		WillReturnError(errors.New("exec error"))
+	mock.ExpectExec(`DELETE FROM "items"`).
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	logger := new(dbTestStructuredLogger)
+	db := goqu.New("postgres", mDB)
+	opts := goqu.DefaultStructuredLoggerOptions()
+	opts.SlowThreshold = 5 * time.Millisecond
+	opts.RedactArgs = func(query string, args []interface{}) []interface{} {
+		redacted := make([]interface{}, len(args))
+		for i := range args {
+			redacted[i] = "***"
+		}
+		return redacted
+	}
+	db.StructuredLogger(logger, opts)
+
+	var names []string
+	ds.NoError(db.From("items").Prepared(true).Select("name").Where(goqu.C("id").Eq(1)).ScanVals(&names))
+	ds.NoError(db.From("items").Select("name").ScanVals(&names))
+	_, err = db.Delete("items").Prepared(true).Where(goqu.C("id").Eq(2)).Executor().Exec()
+	ds.EqualError(err, "goqu: exec error")
+
+	db.StructuredLogger(nil, opts)
+	_, err = db.Delete("items").Executor().Exec()
+	ds.NoError(err)
+
+	ds.Equal([]string{
+		`-4 goqu query [op QUERY sql SELECT "name" FROM "items" WHERE ("id" = $1) args [***] duration true]`,
+		`4 goqu slow query [op QUERY sql SELECT "name" FROM "items" duration true]`,
+		`8 goqu query [op EXEC sql DELETE FROM "items" WHERE ("id" = $1) args [***] duration true error goqu: exec error]`,
+	}, logger.Messages)
+	ds.NoError(mock.ExpectationsWereMet())
+}
+
 func (ds *databaseSuite) TestScanStructs() {
 	mDB, mock, err := sqlmock.New()
 	ds.NoError(err)
@@ -463,6 +680,87 @@ func (tds *txdatabaseSuite) TestLogger_FromDb() {
 	}, logger.Messages)
 }
 
+func (tds *txdatabaseSuite) TestAddQueryHook() {
+	mDB, mock, err := sqlmock.New()
+	tds.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1"))
+	mock.ExpectExec(`DELETE FROM "items"`).
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	var dbMessages, txMessages []string
+	db := goqu.New("db-mock", mDB)
+	db.AddQueryHook(&dbTestMockHook{name: "db", Messages: &dbMessages})
+	tx, err := db.Begin()
+	tds.NoError(err)
+	tx.AddQueryHook(&dbTestMockResultHook{dbTestMockHook{name: "tx", Messages: &txMessages}})
+
+	var names []string
+	tds.NoError(tx.From("items").Select("name").ScanVals(&names))
+	_, err = tx.Delete("items").Executor().Exec()
+	tds.NoError(err)
+	tds.NoError(tx.Commit())
+
+	tds.Equal([]string{
+		"db before QUERY [query:=`SELECT \"name\" FROM \"items\"` args:=[]]",
+		"db after QUERY [ctx:=true err:=<nil> duration:=true]",
+		"db before EXEC [query:=`DELETE FROM \"items\"` args:=[]]",
+		"db after EXEC [ctx:=true err:=<nil> duration:=true]",
+	}, dbMessages)
+	tds.Equal([]string{
+		"tx before QUERY [query:=`SELECT \"name\" FROM \"items\"` args:=[]]",
+		"tx after QUERY [ctx:=true rows:=-1 err:=<nil> duration:=true]",
+		"tx before EXEC [query:=`DELETE FROM \"items\"` args:=[]]",
+		"tx after EXEC [ctx:=true rows:=1 err:=<nil> duration:=true]",
+	}, txMessages)
+	tds.NoError(mock.ExpectationsWereMet())
+}
+
+func (tds *txdatabaseSuite) TestStructuredLogger() {
+	mDB, mock, err := sqlmock.New()
+	tds.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "items"`).
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "items"`).
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	dbLogger := new(dbTestStructuredLogger)
+	db := goqu.New("postgres", mDB)
+	db.StructuredLogger(dbLogger, goqu.DefaultStructuredLoggerOptions())
+	tx, err := db.Begin()
+	tds.NoError(err)
+	_, err = tx.Delete("items").Executor().Exec()
+	tds.NoError(err)
+
+	txLogger := new(dbTestStructuredLogger)
+	opts := goqu.DefaultStructuredLoggerOptions()
+	opts.Level = goqu.LogLevelInfo
+	tx.StructuredLogger(txLogger, opts)
+	ctx := context.Background()
+	_, err = tx.ExecContext(ctx, `DELETE FROM "items"`)
+	tds.NoError(err)
+	tds.NoError(tx.Commit())
+
+	txID, ok := goqu.TxIDFromContext(ctx)
+	tds.False(ok)
+	tds.Zero(txID)
+	tds.Len(dbLogger.Messages, 1)
+	tds.Regexp(`^-4 goqu query \[op EXEC sql DELETE FROM "items" duration true tx_id \d+\]$`, dbLogger.Messages[0])
+	tds.Len(txLogger.Messages, 1)
+	tds.Regexp(`^0 goqu query \[op EXEC sql DELETE FROM "items" duration true tx_id \d+\]$`, txLogger.Messages[0])
+	tds.Equal(dbLogger.Messages[0][strings.Index(dbLogger.Messages[0], "tx_id"):],
+		txLogger.Messages[0][strings.Index(txLogger.Messages[0], "tx_id"):])
+	tds.NoError(mock.ExpectationsWereMet())
+}
+
 func (tds *txdatabaseSuite) TestCommit() {
 	mDB, mock, err := sqlmock.New()
 	tds.NoError(err)
@@ -716,6 +1014,115 @@ func (tds *txdatabaseSuite) TestWrap() {
 	}), "goqu: tx error")
 }
 
+func (tds *txdatabaseSuite) TestWithTx() {
+	mDB, mock, err := sqlmock.New()
+	tds.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectExec(`SAVEPOINT goqu_savepoint_1`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "items"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`SAVEPOINT goqu_savepoint_2`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "other"`).WillReturnError(errors.New("delete error"))
+	mock.ExpectExec(`ROLLBACK TO SAVEPOINT goqu_savepoint_2`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`RELEASE SAVEPOINT goqu_savepoint_2`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`RELEASE SAVEPOINT goqu_savepoint_1`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`SAVEPOINT goqu_savepoint_3`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`ROLLBACK TO SAVEPOINT goqu_savepoint_3`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	db := goqu.New("mock", mDB)
+	tds.NoError(db.WithTx(func(tx *goqu.TxDatabase) error {
+		tds.NoError(tx.WithTx(func(tx *goqu.TxDatabase) error {
+			if _, execErr := tx.Exec(`DELETE FROM "items"`); execErr != nil {
+				return execErr
+			}
+			tds.EqualError(tx.WithTx(func(tx *goqu.TxDatabase) error {
+				_, execErr := tx.Exec(`DELETE FROM "other"`)
+				return execErr
+			}), "goqu: delete error")
+			return nil
+		}))
+		tds.PanicsWithValue("tx panic", func() {
+			_ = tx.WithTx(func(tx *goqu.TxDatabase) error {
+				panic("tx panic")
+			})
+		})
+		return nil
+	}))
+	tds.NoError(mock.ExpectationsWereMet())
+}
+
+func (tds *txdatabaseSuite) TestWithTx_SavepointError() {
+	mDB, mock, err := sqlmock.New()
+	tds.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectExec(`SAVEPOINT goqu_savepoint_1`).WillReturnError(errors.New("savepoint error"))
+	mock.ExpectExec(`SAVEPOINT goqu_savepoint_2`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`ROLLBACK TO SAVEPOINT goqu_savepoint_2`).WillReturnError(errors.New("rollback error"))
+
+	db := goqu.New("mock", mDB)
+	tx, err := db.Begin()
+	tds.NoError(err)
+	called := false
+	tds.EqualError(tx.WithTx(func(tx *goqu.TxDatabase) error {
+		called = true
+		return nil
+	}), "goqu: savepoint error")
+	tds.False(called)
+	fnErr := errors.New("fn error")
+	err = tx.WithTx(func(tx *goqu.TxDatabase) error {
+		return fnErr
+	})
+	tds.EqualError(err, "goqu: fn error: unable to rollback to savepoint goqu_savepoint_2: goqu: rollback error")
+	tds.ErrorIs(err, fnErr)
+	tds.NoError(mock.ExpectationsWereMet())
+}
+
+func (tds *txdatabaseSuite) TestSavepoint() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SavepointFragment = []byte("SAVE TRANSACTION ")
+	opts.ReleaseSavepointFragment = []byte("")
+	opts.RollbackToSavepointFragment = []byte("ROLLBACK TRANSACTION ")
+	goqu.RegisterDialect("savepoint-mock", opts)
+	defer goqu.DeregisterDialect("savepoint-mock")
+	noSavepointOpts := sqlgen.DefaultDialectOptions()
+	noSavepointOpts.SavepointFragment = nil
+	noSavepointOpts.RollbackToSavepointFragment = nil
+	goqu.RegisterDialect("no-savepoint-mock", noSavepointOpts)
+	defer goqu.DeregisterDialect("no-savepoint-mock")
+
+	mDB, mock, err := sqlmock.New()
+	tds.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectExec(`SAVE TRANSACTION sp_1`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`ROLLBACK TRANSACTION sp_1`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	tx, err := goqu.New("savepoint-mock", mDB).Begin()
+	tds.NoError(err)
+	tds.NoError(tx.Savepoint("sp_1"))
+	tds.NoError(tx.ReleaseSavepoint("sp_1"))
+	tds.NoError(tx.RollbackTo("sp_1"))
+	tds.EqualError(
+		tx.Savepoint("sp; DROP TABLE items"),
+		`goqu: invalid savepoint name "sp; DROP TABLE items", a savepoint name must only contain letters, digits and underscores`,
+	)
+	tds.EqualError(
+		tx.RollbackTo("1sp"),
+		`goqu: invalid savepoint name "1sp", a savepoint name must only contain letters, digits and underscores`,
+	)
+	tds.EqualError(
+		tx.RollbackTo(""),
+		`goqu: invalid savepoint name "", a savepoint name must only contain letters, digits and underscores`,
+	)
+	tds.NoError(mock.ExpectationsWereMet())
+
+	tx = goqu.NewTx("no-savepoint-mock", tx.Tx)
+	tds.EqualError(tx.Savepoint("sp_1"), "goqu: dialect does not support savepoints [dialect=no-savepoint-mock]")
+	tds.EqualError(tx.RollbackTo("sp_1"), "goqu: dialect does not support savepoints [dialect=no-savepoint-mock]")
+	tds.EqualError(tx.WithTx(func(tx *goqu.TxDatabase) error {
+		return nil
+	}), "goqu: dialect does not support savepoints [dialect=no-savepoint-mock]")
+}
+
 func (tds *txdatabaseSuite) TestDataRace() {
 	mDB, mock, err := sqlmock.New()
 	tds.NoError(err)
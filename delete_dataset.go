@@ -233,7 +233,8 @@ func (dd *DeleteDataset) ReturnsColumns() bool {
 //
 // See Dataset#ToUpdateSQL for arguments
 func (dd *DeleteDataset) Executor() exec.QueryExecutor {
-	return dd.queryFactory.FromSQLBuilder(dd.deleteSQLBuilder())
+	return dd.queryFactory.FromSQLBuilder(dd.deleteSQLBuilder()).WithOperation(exec.OperationDelete).
+		WithColumnConverter(dd.dialect.DialectOptions().ConvertColumnValue)
 }
 
 func (dd *DeleteDataset) deleteSQLBuilder() sb.SQLBuilder {
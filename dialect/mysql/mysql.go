@@ -1,8 +1,14 @@
 package mysql
 
 import (
+	"errors"
+	"strconv"
+	"strings"
+
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exec"
 	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/go-sql-driver/mysql"
 )
 
 func DialectOptions() *goqu.SQLDialectOptions {
@@ -21,6 +27,14 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.SupportsDistinctOn = false
 	opts.SupportsWindowFunction = false
 	opts.SupportsDeleteTableHint = true
+	opts.SupportsExcept = false
+	opts.SupportsExceptAll = false
+	opts.SupportsFilterClause = false
+	opts.SupportsWithinGroup = false
+	opts.UseWithRollupSyntax = true
+	opts.UseJSONFunctions = true
+	opts.SupportsMerge = false
+	opts.SupportsArrays = false
 
 	opts.UseFromClauseForMultipleUpdateTables = false
 
@@ -31,6 +45,12 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.True = []byte("1")
 	opts.False = []byte("0")
 	opts.TimeFormat = "2006-01-02 15:04:05"
+	opts.MaxParams = 65535
+	opts.IsRetryableError = isRetryableError
+	opts.IsUniqueViolation = hasErrorNumber(uniqueViolationNumbers)
+	opts.IsForeignKeyViolation = hasErrorNumber(foreignKeyViolationNumbers)
+	opts.IsNotNullViolation = hasErrorNumber(notNullViolationNumbers)
+	opts.ConvertColumnValue = convertColumnValue
 	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
 		exp.EqOp:             []byte("="),
 		exp.NeqOp:            []byte("!="),
@@ -72,15 +92,94 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.ConflictFragment = []byte("")
 	opts.ConflictDoUpdateFragment = []byte(" ON DUPLICATE KEY UPDATE ")
 	opts.ConflictDoNothingFragment = []byte("")
+	opts.ExcludedPrefixFragment = []byte("VALUES(")
+	opts.ExcludedSuffixFragment = []byte(")")
+	opts.GroupingSetTypeLookup = map[exp.GroupingSetType][]byte{}
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{
+		exp.JSONGetOp:      []byte("JSON_EXTRACT"),
+		exp.JSONGetTextOp:  []byte("JSON_EXTRACT"),
+		exp.JSONPathOp:     []byte("JSON_EXTRACT"),
+		exp.JSONPathTextOp: []byte("JSON_EXTRACT"),
+		exp.JSONContainsOp: []byte("JSON_CONTAINS"),
+		exp.JSONHasKeyOp:   []byte("JSON_EXTRACT"),
+	}
+	opts.JSONUnquoteFragment = []byte("JSON_UNQUOTE")
 	return opts
 }
 
 func DialectOptionsV8() *goqu.SQLDialectOptions {
 	opts := DialectOptions()
 	opts.SupportsWindowFunction = true
+	opts.SupportsExcept = true
+	opts.SupportsExceptAll = true
+	opts.SupportsWindowFrameExclusion = false
+	opts.WindowFrameUnitLookup = map[exp.WindowFrameUnit][]byte{
+		exp.RowsFrameUnit:  []byte("ROWS"),
+		exp.RangeFrameUnit: []byte("RANGE"),
+	}
 	return opts
 }
 
+// ER_LOCK_DEADLOCK and ER_LOCK_WAIT_TIMEOUT, see https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html
+var retryableErrorNumbers = map[uint16]bool{
+	1213: true,
+	1205: true,
+}
+
+// used by goqu.Database#WithTxRetry to retry transactions that failed because of a deadlock or lock wait timeout
+func isRetryableError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && retryableErrorNumbers[mysqlErr.Number]
+}
+
+// the error numbers used to classify errors, see goqu.IsUniqueViolation
+var (
+	// ER_DUP_ENTRY and ER_DUP_ENTRY_WITH_KEY_NAME
+	uniqueViolationNumbers = map[uint16]bool{1062: true, 1586: true}
+	// ER_ROW_IS_REFERENCED, ER_NO_REFERENCED_ROW, ER_ROW_IS_REFERENCED_2 and ER_NO_REFERENCED_ROW_2
+	foreignKeyViolationNumbers = map[uint16]bool{1216: true, 1217: true, 1451: true, 1452: true}
+	// ER_BAD_NULL_ERROR and ER_NO_DEFAULT_FOR_FIELD
+	notNullViolationNumbers = map[uint16]bool{1048: true, 1364: true}
+)
+
+// returns a func that returns true if the error is a *mysql.MySQLError with one of the numbers
+func hasErrorNumber(numbers map[uint16]bool) func(err error) bool {
+	return func(err error) bool {
+		var mysqlErr *mysql.MySQLError
+		return errors.As(err, &mysqlErr) && numbers[mysqlErr.Number]
+	}
+}
+
+// the database types of columns that are returned as []byte by the text protocol and converted by convertColumnValue
+var (
+	intColumnTypes = map[string]bool{
+		"TINYINT": true, "SMALLINT": true, "MEDIUMINT": true, "INT": true, "BIGINT": true, "YEAR": true,
+	}
+	floatColumnTypes = map[string]bool{"FLOAT": true, "DOUBLE": true}
+	timeColumnTypes  = map[string]bool{"DATE": true, "DATETIME": true, "TIMESTAMP": true, "TIME": true}
+)
+
+// used by exec.RecordScanner#ScanRecord, the text protocol (non prepared statements) returns every value as []byte so
+// numbers are parsed and text, decimal and time (when parseTime is not set) values are converted to a string.
+func convertColumnValue(databaseTypeName string, val interface{}) (interface{}, error) {
+	b, ok := val.([]byte)
+	if !ok {
+		return val, nil
+	}
+	switch {
+	case intColumnTypes[databaseTypeName]:
+		return strconv.ParseInt(string(b), 10, 64)
+	case strings.HasPrefix(databaseTypeName, "UNSIGNED "):
+		return strconv.ParseUint(string(b), 10, 64)
+	case floatColumnTypes[databaseTypeName]:
+		return strconv.ParseFloat(string(b), 64)
+	case timeColumnTypes[databaseTypeName]:
+		return string(b), nil
+	default:
+		return exec.ConvertColumnValue(databaseTypeName, val)
+	}
+}
+
 func init() {
 	goqu.RegisterDialect("mysql", DialectOptions())
 	goqu.RegisterDialect("mysql8", DialectOptionsV8())
@@ -1,11 +1,17 @@
 package mysql_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/mysql"
 	"github.com/doug-martin/goqu/v9/exp"
+	driver "github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -125,6 +131,51 @@ func (mds *mysqlDialectSuite) TestBitwiseOperations() {
 	)
 }
 
+func (mds *mysqlDialectSuite) TestArrayOperations() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(goqu.C("tags").Array().Contains([]string{"a"})),
+			err: "goqu: dialect does not support arrays [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.Array([]int{1, 2})),
+			err: "goqu: dialect does not support arrays [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestJSONOperations() {
+	doc := goqu.C("doc")
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{ds: ds.Select(doc.JSON().Get("a")), sql: "SELECT JSON_EXTRACT(`doc`, '$.a') FROM `test`"},
+		sqlTestCase{
+			ds:  ds.Select(doc.JSON().GetText("a")),
+			sql: "SELECT JSON_UNQUOTE(JSON_EXTRACT(`doc`, '$.a')) FROM `test`",
+		},
+		sqlTestCase{ds: ds.Select(doc.JSON().Path("a", 0)), sql: "SELECT JSON_EXTRACT(`doc`, '$.a[0]') FROM `test`"},
+		sqlTestCase{
+			ds:  ds.Select(doc.JSON().PathText("a", 0)),
+			sql: "SELECT JSON_UNQUOTE(JSON_EXTRACT(`doc`, '$.a[0]')) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Where(doc.JSON().Contains(map[string]int{"a": 1})),
+			sql: "SELECT * FROM `test` WHERE JSON_CONTAINS(`doc`, '{\\\"a\\\":1}')",
+		},
+		sqlTestCase{
+			ds:  ds.Where(doc.JSON().HasKey("a")),
+			sql: "SELECT * FROM `test` WHERE (JSON_EXTRACT(`doc`, '$.a') IS NOT NULL)",
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(doc.JSON().GetText("a").Eq("b")),
+			sql:        "SELECT * FROM `test` WHERE (JSON_UNQUOTE(JSON_EXTRACT(`doc`, ?)) = ?)",
+			isPrepared: true,
+			args:       []interface{}{"$.a", "b"},
+		},
+	)
+}
+
 func (mds *mysqlDialectSuite) TestUpdateSQL() {
 	ds := mds.GetDs("test").Update()
 	mds.assertSQL(
@@ -138,6 +189,190 @@ func (mds *mysqlDialectSuite) TestUpdateSQL() {
 	)
 }
 
+func (mds *mysqlDialectSuite) TestCompoundExpressions() {
+	ds1 := mds.GetDs("test").Select("a")
+	ds2 := mds.GetDs("test2").Select("b")
+	mds.assertSQL(
+		sqlTestCase{ds: ds1.Union(ds2), sql: "SELECT `a` FROM `test` UNION (SELECT `b` FROM `test2`)"},
+		sqlTestCase{ds: ds1.Except(ds2), err: "goqu: dialect does not support EXCEPT clause [dialect=mysql]"},
+		sqlTestCase{ds: ds1.ExceptAll(ds2), err: "goqu: dialect does not support EXCEPT ALL clause [dialect=mysql]"},
+	)
+
+	ds1 = goqu.Dialect("mysql8").From("test").Select("a")
+	ds2 = goqu.Dialect("mysql8").From("test2").Select("b")
+	mds.assertSQL(
+		sqlTestCase{ds: ds1.Except(ds2), sql: "SELECT `a` FROM `test` EXCEPT (SELECT `b` FROM `test2`)"},
+		sqlTestCase{ds: ds1.ExceptAll(ds2), sql: "SELECT `a` FROM `test` EXCEPT ALL (SELECT `b` FROM `test2`)"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestAggregateFilter() {
+	ds := mds.GetDs("test")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.COUNT(goqu.Star()).Filter(goqu.C("a").Eq(1))),
+			sql: "SELECT COUNT(CASE  WHEN (`a` = 1) THEN 1 END) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.SUM("b").Filter(goqu.C("a").Eq(1))),
+			sql: "SELECT SUM(CASE  WHEN (`a` = 1) THEN `b` END) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.Func("PERCENTILE_CONT", 0.5).WithinGroup(goqu.C("a").Asc())),
+			err: "goqu: dialect does not support WITHIN GROUP clause [dialect=mysql]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestGroupingSets() {
+	ds := mds.GetDs("test").Select("a", "b", goqu.SUM("c"))
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.GroupBy(goqu.Rollup("a", "b")),
+			sql: "SELECT `a`, `b`, SUM(`c`) FROM `test` GROUP BY `a`, `b` WITH ROLLUP",
+		},
+		sqlTestCase{
+			ds:  ds.GroupBy("a", goqu.Rollup("b")),
+			err: "goqu: dialect only supports a single ROLLUP of columns in a GROUP BY clause [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds.GroupBy(goqu.Cube("a", "b")),
+			err: "goqu: dialect only supports a single ROLLUP of columns in a GROUP BY clause [dialect=mysql]",
+		},
+		sqlTestCase{
+			ds:  ds.Select("a", goqu.GROUPING("a")).GroupBy(goqu.Rollup("a")),
+			sql: "SELECT `a`, GROUPING(`a`) FROM `test` GROUP BY `a` WITH ROLLUP",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestWindowFrames() {
+	ds := goqu.Dialect("mysql8").From("test")
+	w := goqu.W().OrderBy("a")
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.SUM("b").Over(w.Rows(goqu.UnboundedPreceding(), goqu.CurrentRow()))),
+			sql: "SELECT SUM(`b`) OVER (ORDER BY `a` ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.SUM("b").Over(w.Range(goqu.Preceding(1), goqu.Following(1)))),
+			sql: "SELECT SUM(`b`) OVER (ORDER BY `a` RANGE BETWEEN 1 PRECEDING AND 1 FOLLOWING) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.SUM("b").Over(w.Groups(goqu.CurrentRow(), nil))),
+			err: "goqu: dialect does not support GROUPS window frames [dialect=mysql8]",
+		},
+		sqlTestCase{
+			ds: ds.Select(goqu.SUM("b").Over(
+				w.Rows(goqu.CurrentRow(), nil).Exclude(goqu.ExcludeTies),
+			)),
+			err: "goqu: dialect does not support window frame EXCLUDE TIES [dialect=mysql8]",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestUpsert() {
+	ds := goqu.Dialect("mysql").Insert("test").Rows(goqu.Record{"id": 1, "a": "b"})
+	mds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Upsert("id"),
+			sql: "INSERT IGNORE INTO `test` (`a`, `id`) VALUES ('b', 1) ON DUPLICATE KEY UPDATE `a`=VALUES(`a`)",
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Upsert("id"),
+			sql:        "INSERT IGNORE INTO `test` (`a`, `id`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `a`=VALUES(`a`)",
+			isPrepared: true,
+			args:       []interface{}{"b", int64(1)},
+		},
+		sqlTestCase{
+			ds:  ds.OnConflict(goqu.DoUpdate("", goqu.Record{"a": goqu.Excluded("a")})),
+			sql: "INSERT IGNORE INTO `test` (`a`, `id`) VALUES ('b', 1) ON DUPLICATE KEY UPDATE `a`=VALUES(`a`)",
+		},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestMerge() {
+	ds := goqu.Dialect("mysql").
+		Merge("items").
+		Using("new_items").
+		On(goqu.I("items.id").Eq(goqu.I("new_items.id"))).
+		WhenMatched(goqu.MergeDelete())
+	mds.assertSQL(
+		sqlTestCase{ds: ds, err: "goqu: dialect does not support MERGE statements [dialect=mysql]"},
+	)
+}
+
+func (mds *mysqlDialectSuite) TestIsRetryableError() {
+	for _, opts := range []*goqu.SQLDialectOptions{mysql.DialectOptions(), mysql.DialectOptionsV8()} {
+		isRetryable := opts.IsRetryableError
+		mds.Require().NotNil(isRetryable)
+		mds.True(isRetryable(&driver.MySQLError{Number: 1213}))
+		mds.True(isRetryable(&driver.MySQLError{Number: 1205}))
+		mds.True(isRetryable(fmt.Errorf("wrapped: %w", &driver.MySQLError{Number: 1213})))
+		mds.False(isRetryable(&driver.MySQLError{Number: 1062}))
+		mds.False(isRetryable(errors.New("deadlock")))
+	}
+}
+
+func (mds *mysqlDialectSuite) TestConstraintViolations() {
+	opts := mysql.DialectOptions()
+	mds.True(opts.IsUniqueViolation(&driver.MySQLError{Number: 1062}))
+	mds.True(opts.IsUniqueViolation(fmt.Errorf("wrapped: %w", &driver.MySQLError{Number: 1586})))
+	mds.False(opts.IsUniqueViolation(&driver.MySQLError{Number: 1452}))
+	mds.True(opts.IsForeignKeyViolation(&driver.MySQLError{Number: 1451}))
+	mds.True(opts.IsForeignKeyViolation(&driver.MySQLError{Number: 1452}))
+	mds.False(opts.IsForeignKeyViolation(&driver.MySQLError{Number: 1062}))
+	mds.True(opts.IsNotNullViolation(&driver.MySQLError{Number: 1048}))
+	mds.True(opts.IsNotNullViolation(&driver.MySQLError{Number: 1364}))
+	mds.False(opts.IsNotNullViolation(errors.New("Column 'name' cannot be null")))
+
+	mds.True(goqu.IsUniqueViolation(&driver.MySQLError{Number: 1062}))
+	mds.True(goqu.IsForeignKeyViolation(&driver.MySQLError{Number: 1452}))
+	mds.True(goqu.IsNotNullViolation(&driver.MySQLError{Number: 1048}))
+}
+
+func (mds *mysqlDialectSuite) TestConvertColumnValue() {
+	convert := mysql.DialectOptions().ConvertColumnValue
+	for _, tc := range []struct {
+		typeName string
+		val      interface{}
+		expected interface{}
+	}{
+		{typeName: "INT", val: []byte("-10"), expected: int64(-10)},
+		{typeName: "BIGINT", val: []byte("10"), expected: int64(10)},
+		{typeName: "UNSIGNED BIGINT", val: []byte("18446744073709551615"), expected: uint64(18446744073709551615)},
+		{typeName: "DOUBLE", val: []byte("1.5"), expected: 1.5},
+		{typeName: "DECIMAL", val: []byte("1.50"), expected: "1.50"},
+		{typeName: "VARCHAR", val: []byte("Test"), expected: "Test"},
+		{typeName: "TEXT", val: []byte("Test"), expected: "Test"},
+		{typeName: "DATETIME", val: []byte("2020-01-01 00:00:00"), expected: "2020-01-01 00:00:00"},
+		{typeName: "BLOB", val: []byte("Test"), expected: []byte("Test")},
+		{typeName: "INT", val: int64(10), expected: int64(10)},
+		{typeName: "VARCHAR", val: nil, expected: nil},
+	} {
+		val, err := convert(tc.typeName, tc.val)
+		mds.NoError(err, tc.typeName)
+		mds.Equal(tc.expected, val, tc.typeName)
+	}
+	_, err := convert("INT", []byte("a"))
+	mds.Error(err)
+}
+
+func (mds *mysqlDialectSuite) TestScanRecords() {
+	mDB, sqlMock, err := sqlmock.New()
+	mds.Require().NoError(err)
+	sqlMock.ExpectQuery("SELECT \\* FROM `items`").
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(
+			sqlmock.NewColumn("id").OfType("BIGINT", []byte{}),
+			sqlmock.NewColumn("name").OfType("VARCHAR", []byte{}),
+		).AddRow([]byte("1"), []byte("Test")))
+
+	records, err := goqu.New("mysql", mDB).From("items").Executor().ScanRecords(context.Background())
+	mds.NoError(err)
+	mds.Equal([]exp.Record{{"id": int64(1), "name": "Test"}}, records)
+	mds.NoError(sqlMock.ExpectationsWereMet())
+}
+
 func TestDatasetAdapterSuite(t *testing.T) {
 	suite.Run(t, new(mysqlDialectSuite))
 }
@@ -1,16 +1,62 @@
 package postgres
 
 import (
+	"database/sql/driver"
+	"errors"
+
 	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
 )
 
 func DialectOptions() *goqu.SQLDialectOptions {
 	do := goqu.DefaultDialectOptions()
 	do.PlaceHolderFragment = []byte("$")
 	do.IncludePlaceholderNum = true
+	do.MaxParams = 65535
+	do.UseArrayParamsForIn = true
+	do.ArrayValuer = func(elements interface{}) driver.Valuer {
+		return pq.GenericArray{A: elements}
+	}
+	do.CopyInQuery = func(schema, table string, columns []string) string {
+		if schema == "" {
+			return pq.CopyIn(table, columns...)
+		}
+		return pq.CopyInSchema(schema, table, columns...)
+	}
+	do.IsRetryableError = isRetryableError
+	do.IsUniqueViolation = hasErrorCode(uniqueViolationCode)
+	do.IsForeignKeyViolation = hasErrorCode(foreignKeyViolationCode)
+	do.IsNotNullViolation = hasErrorCode(notNullViolationCode)
 	return do
 }
 
+// serialization_failure and deadlock_detected, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+var retryableErrorCodes = map[pq.ErrorCode]bool{
+	"40001": true,
+	"40P01": true,
+}
+
+// used by goqu.Database#WithTxRetry to retry transactions that failed with a serialization failure or deadlock
+func isRetryableError(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && retryableErrorCodes[pqErr.Code]
+}
+
+// the integrity constraint violation codes used to classify errors, see goqu.IsUniqueViolation
+const (
+	uniqueViolationCode     pq.ErrorCode = "23505"
+	foreignKeyViolationCode pq.ErrorCode = "23503"
+	notNullViolationCode    pq.ErrorCode = "23502"
+)
+
+// returns a func that returns true if the error is a *pq.Error with the code
+func hasErrorCode(code pq.ErrorCode) func(err error) bool {
+	return func(err error) bool {
+		var pqErr *pq.Error
+		return errors.As(err, &pqErr) && pqErr.Code == code
+	}
+}
+
 func init() {
 	goqu.RegisterDialect("postgres", DialectOptions())
 }
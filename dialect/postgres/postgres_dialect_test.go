@@ -0,0 +1,46 @@
+package postgres_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/suite"
+)
+
+type postgresDialectSuite struct {
+	suite.Suite
+}
+
+func (pds *postgresDialectSuite) TestIsRetryableError() {
+	isRetryable := postgres.DialectOptions().IsRetryableError
+	pds.Require().NotNil(isRetryable)
+	pds.True(isRetryable(&pq.Error{Code: "40001"}))
+	pds.True(isRetryable(&pq.Error{Code: "40P01"}))
+	pds.True(isRetryable(fmt.Errorf("wrapped: %w", &pq.Error{Code: "40001"})))
+	pds.False(isRetryable(&pq.Error{Code: "23505"}))
+	pds.False(isRetryable(errors.New("deadlock")))
+}
+
+func (pds *postgresDialectSuite) TestConstraintViolations() {
+	opts := postgres.DialectOptions()
+	pds.True(opts.IsUniqueViolation(&pq.Error{Code: "23505"}))
+	pds.True(opts.IsUniqueViolation(fmt.Errorf("wrapped: %w", &pq.Error{Code: "23505"})))
+	pds.False(opts.IsUniqueViolation(&pq.Error{Code: "23503"}))
+	pds.True(opts.IsForeignKeyViolation(&pq.Error{Code: "23503"}))
+	pds.False(opts.IsForeignKeyViolation(&pq.Error{Code: "23502"}))
+	pds.True(opts.IsNotNullViolation(&pq.Error{Code: "23502"}))
+	pds.False(opts.IsNotNullViolation(errors.New("23502")))
+
+	pds.True(goqu.IsUniqueViolation(&pq.Error{Code: "23505"}))
+	pds.True(goqu.IsForeignKeyViolation(&pq.Error{Code: "23503"}))
+	pds.True(goqu.IsNotNullViolation(&pq.Error{Code: "23502"}))
+	pds.False(goqu.IsUniqueViolation(nil))
+}
+
+func TestPostgresDialectSuite(t *testing.T) {
+	suite.Run(t, new(postgresDialectSuite))
+}
@@ -1,6 +1,7 @@
 package postgres_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
@@ -306,6 +307,37 @@ func (pt *postgresTest) TestInsert() {
 	pt.Len(newEntries, 4)
 }
 
+func (pt *postgresTest) TestCopyFrom() {
+	now := time.Now()
+	entries := []entry{
+		{Int: 11, Float: 1.100000, String: "1.100000", Time: now, Bool: false, Bytes: []byte("1.100000")},
+		{Int: 12, Float: 1.200000, String: "1.200000", Time: now, Bool: true, Bytes: []byte("1.200000")},
+		{Int: 13, Float: 1.300000, String: "1.300000", Time: now, Bool: false, Bytes: []byte("1.300000")},
+	}
+	copied, err := pt.db.Insert("entry").Rows(entries).CopyFrom(context.Background())
+	pt.NoError(err)
+	pt.Equal(int64(3), copied)
+
+	var newEntries []entry
+	pt.NoError(pt.db.From("entry").Where(goqu.C("int").In([]uint32{11, 12, 13})).Order(goqu.C("int").Asc()).ScanStructs(&newEntries))
+	pt.Len(newEntries, 3)
+	for i, e := range newEntries {
+		pt.True(e.ID > 0)
+		pt.Equal(entries[i].Int, e.Int)
+		pt.Equal(entries[i].Float, e.Float)
+		pt.Equal(entries[i].String, e.String)
+		pt.Equal(entries[i].Time.Unix(), e.Time.Unix())
+		pt.Equal(entries[i].Bool, e.Bool)
+	}
+
+	// the copy is rolled back if any row fails
+	_, err = pt.db.Insert("entry").Rows(entries[0]).CopyFrom(context.Background())
+	pt.Error(err)
+	count, err := pt.db.From("entry").Count()
+	pt.NoError(err)
+	pt.Equal(int64(13), count)
+}
+
 func (pt *postgresTest) TestInsertReturning() {
 	ds := pt.db.From("entry")
 	now := time.Now()
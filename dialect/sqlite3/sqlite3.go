@@ -1,6 +1,7 @@
 package sqlite3
 
 import (
+	"strings"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -18,11 +19,17 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.SupportsConflictUpdateWhere = false
 	opts.SupportsInsertIgnoreSyntax = true
 	opts.SupportsConflictTarget = true
+	opts.SupportsConflictConstraint = false
 	opts.SupportsMultipleUpdateTables = false
 	opts.WrapCompoundsInParens = false
 	opts.SupportsDistinctOn = false
 	opts.SupportsWindowFunction = false
 	opts.SupportsLateral = false
+	opts.SupportsExceptAll = false
+	opts.SupportsWithinGroup = false
+	opts.SupportsMerge = false
+	opts.SupportsArrays = false
+	opts.UseJSONFunctions = true
 
 	opts.PlaceHolderFragment = []byte("?")
 	opts.IncludePlaceholderNum = false
@@ -31,6 +38,10 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.True = []byte("1")
 	opts.False = []byte("0")
 	opts.TimeFormat = time.RFC3339Nano
+	opts.MaxParams = 999
+	opts.IsUniqueViolation = hasErrorMessage("UNIQUE constraint failed")
+	opts.IsForeignKeyViolation = hasErrorMessage("FOREIGN KEY constraint failed")
+	opts.IsNotNullViolation = hasErrorMessage("NOT NULL constraint failed")
 	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
 		exp.EqOp:             []byte("="),
 		exp.NeqOp:            []byte("!="),
@@ -68,9 +79,25 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.ForUpdateFragment = []byte("")
 	opts.OfFragment = []byte("")
 	opts.NowaitFragment = []byte("")
+	opts.GroupingSetTypeLookup = map[exp.GroupingSetType][]byte{}
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{
+		exp.JSONGetOp:      []byte("JSON_EXTRACT"),
+		exp.JSONGetTextOp:  []byte("JSON_EXTRACT"),
+		exp.JSONPathOp:     []byte("JSON_EXTRACT"),
+		exp.JSONPathTextOp: []byte("JSON_EXTRACT"),
+		exp.JSONHasKeyOp:   []byte("JSON_TYPE"),
+	}
 	return opts
 }
 
+// returns a func that returns true if the error message contains msg. The message is used instead of the error type of
+// the driver so the dialect does not depend on a cgo driver and works with any sqlite driver.
+func hasErrorMessage(msg string) func(err error) bool {
+	return func(err error) bool {
+		return err != nil && strings.Contains(err.Error(), msg)
+	}
+}
+
 func init() {
 	goqu.RegisterDialect("sqlite3", DialectOptions())
 }
@@ -1,6 +1,8 @@
 package sqlite3_test
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -75,6 +77,30 @@ func (sds *sqlite3DialectSuite) TestCompoundExpressions() {
 		sqlTestCase{ds: ds1.Union(ds2), sql: "SELECT `a` FROM `test` UNION SELECT `b` FROM `test2`"},
 		sqlTestCase{ds: ds1.UnionAll(ds2), sql: "SELECT `a` FROM `test` UNION ALL SELECT `b` FROM `test2`"},
 		sqlTestCase{ds: ds1.Intersect(ds2), sql: "SELECT `a` FROM `test` INTERSECT SELECT `b` FROM `test2`"},
+		sqlTestCase{ds: ds1.Except(ds2), sql: "SELECT `a` FROM `test` EXCEPT SELECT `b` FROM `test2`"},
+		sqlTestCase{ds: ds1.ExceptAll(ds2), err: "goqu: dialect does not support EXCEPT ALL clause [dialect=sqlite3]"},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestAggregateFilter() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.COUNT(goqu.Star()).Filter(goqu.C("a").Eq(1))),
+			sql: "SELECT COUNT(*) FILTER (WHERE (`a` = 1)) FROM `test`",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.Func("PERCENTILE_CONT", 0.5).WithinGroup(goqu.C("a").Asc())),
+			err: "goqu: dialect does not support WITHIN GROUP clause [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestGroupingSets() {
+	ds := sds.GetDs("test").Select("a", "b", goqu.SUM("c"))
+	sds.assertSQL(
+		sqlTestCase{ds: ds.GroupBy(goqu.Rollup("a", "b")), err: "goqu: dialect does not support ROLLUP [dialect=sqlite3]"},
+		sqlTestCase{ds: ds.GroupBy(goqu.Cube("a", "b")), err: "goqu: dialect does not support CUBE [dialect=sqlite3]"},
 	)
 }
 
@@ -145,6 +171,44 @@ func (sds *sqlite3DialectSuite) TestBitwiseOperations() {
 	)
 }
 
+func (sds *sqlite3DialectSuite) TestArrayOperations() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(goqu.C("tags").Array().Contains([]string{"a"})),
+			err: "goqu: dialect does not support arrays [dialect=sqlite3]",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.Array([]int{1, 2})),
+			err: "goqu: dialect does not support arrays [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestJSONOperations() {
+	doc := goqu.C("doc")
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{ds: ds.Select(doc.JSON().Get("a")), sql: "SELECT JSON_EXTRACT(`doc`, '$.a') FROM `test`"},
+		sqlTestCase{ds: ds.Select(doc.JSON().GetText("a")), sql: "SELECT JSON_EXTRACT(`doc`, '$.a') FROM `test`"},
+		sqlTestCase{ds: ds.Select(doc.JSON().Path("a", 0)), sql: "SELECT JSON_EXTRACT(`doc`, '$.a[0]') FROM `test`"},
+		sqlTestCase{
+			ds:  ds.Where(doc.JSON().HasKey("a")),
+			sql: "SELECT * FROM `test` WHERE (JSON_TYPE(`doc`, '$.a') IS NOT NULL)",
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(doc.JSON().GetText("a").Eq("b")),
+			sql:        "SELECT * FROM `test` WHERE (JSON_EXTRACT(`doc`, ?) = ?)",
+			isPrepared: true,
+			args:       []interface{}{"$.a", "b"},
+		},
+		sqlTestCase{
+			ds:  ds.Where(doc.JSON().Contains(map[string]int{"a": 1})),
+			err: "goqu: dialect does not support JSON operator 'contains' [dialect=sqlite3]",
+		},
+	)
+}
+
 func (sds *sqlite3DialectSuite) TestForUpdate() {
 	ds := sds.GetDs("test")
 	sds.assertSQL(
@@ -153,6 +217,59 @@ func (sds *sqlite3DialectSuite) TestForUpdate() {
 	)
 }
 
+func (sds *sqlite3DialectSuite) TestInsertConflictTarget() {
+	ds := goqu.Dialect("sqlite3").Insert("test").Rows(goqu.Record{"a": 1})
+	sds.assertSQL(
+		sqlTestCase{
+			ds: ds.OnConflict(goqu.DoUpdateOn(
+				goqu.ConflictColumns("a").Where(goqu.C("b").IsNull()),
+				goqu.Record{"a": goqu.I("excluded.a")},
+			)),
+			sql: "INSERT OR IGNORE INTO  `test` (`a`) VALUES (1) ON CONFLICT  (`a`) WHERE (`b` IS NULL) DO UPDATE SET `a`=`excluded`.`a`",
+		},
+		sqlTestCase{
+			ds:  ds.OnConflict(goqu.DoUpdateOn(goqu.ConflictConstraint("test_a_key"), goqu.Record{"a": 2})),
+			err: "goqu: dialect does not support on conflict on constraint [dialect=sqlite3]",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestUpsert() {
+	ds := goqu.Dialect("sqlite3").Insert("test").Rows(goqu.Record{"id": 1, "a": "b"})
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Upsert("id"),
+			sql: "INSERT OR IGNORE INTO  `test` (`a`, `id`) VALUES ('b', 1) ON CONFLICT  (`id`) DO UPDATE SET `a`=EXCLUDED.`a`",
+		},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestMerge() {
+	ds := goqu.Dialect("sqlite3").
+		Merge("items").
+		Using("new_items").
+		On(goqu.I("items.id").Eq(goqu.I("new_items.id"))).
+		WhenMatched(goqu.MergeDelete())
+	sds.assertSQL(
+		sqlTestCase{ds: ds, err: "goqu: dialect does not support MERGE statements [dialect=sqlite3]"},
+	)
+}
+
+func (sds *sqlite3DialectSuite) TestConstraintViolations() {
+	opts := goqu.GetDialect("sqlite3").DialectOptions()
+	sds.True(opts.IsUniqueViolation(errors.New("UNIQUE constraint failed: items.name")))
+	sds.True(opts.IsUniqueViolation(fmt.Errorf("wrapped: %w", errors.New("UNIQUE constraint failed: items.id"))))
+	sds.False(opts.IsUniqueViolation(errors.New("NOT NULL constraint failed: items.name")))
+	sds.True(opts.IsForeignKeyViolation(errors.New("FOREIGN KEY constraint failed")))
+	sds.False(opts.IsForeignKeyViolation(errors.New("UNIQUE constraint failed: items.name")))
+	sds.True(opts.IsNotNullViolation(errors.New("NOT NULL constraint failed: items.name")))
+	sds.False(opts.IsNotNullViolation(nil))
+
+	sds.True(goqu.IsUniqueViolation(errors.New("UNIQUE constraint failed: items.name")))
+	sds.True(goqu.IsForeignKeyViolation(errors.New("FOREIGN KEY constraint failed")))
+	sds.True(goqu.IsNotNullViolation(errors.New("NOT NULL constraint failed: items.name")))
+}
+
 func TestDatasetAdapterSuite(t *testing.T) {
 	suite.Run(t, new(sqlite3DialectSuite))
 }
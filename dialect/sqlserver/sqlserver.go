@@ -1,6 +1,10 @@
 package sqlserver
 
 import (
+	"errors"
+	"strings"
+
+	mssql "github.com/denisenkom/go-mssqldb"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/doug-martin/goqu/v9/sqlgen"
@@ -20,19 +24,40 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.SupportsConflictUpdateWhere = false
 	opts.SupportsInsertIgnoreSyntax = false
 	opts.SupportsConflictTarget = false
+	opts.SupportsExcludedRow = false
 	opts.SupportsWithCTE = false
 	opts.SupportsWithCTERecursive = false
 	opts.SupportsDistinctOn = false
 	opts.SupportsWindowFunction = false
+	opts.SupportsExceptAll = false
+	opts.SupportsFilterClause = false
+	opts.SupportsMergeDoNothing = false
+	opts.SupportsArrays = false
+	opts.SupportsRowValueComparison = false
 	opts.SurroundLimitWithParentheses = true
+	opts.UseJSONFunctions = true
 
 	opts.PlaceHolderFragment = []byte("@p")
 	opts.LimitFragment = []byte(" TOP ")
 	opts.IncludePlaceholderNum = true
 	opts.DefaultValuesFragment = []byte("")
+	opts.MergeEndFragment = []byte(";")
 	opts.True = []byte("1")
 	opts.False = []byte("0")
 	opts.TimeFormat = "2006-01-02 15:04:05"
+	opts.MaxParams = 2100
+	opts.MaxInsertRows = 1000
+	opts.CopyInQuery = func(schema, table string, columns []string) string {
+		name := quoteIdentifier(table)
+		if schema != "" {
+			name = quoteIdentifier(schema) + "." + name
+		}
+		return mssql.CopyIn(name, mssql.BulkOptions{}, columns...)
+	}
+	opts.IsRetryableError = isRetryableError
+	opts.IsUniqueViolation = isUniqueViolation
+	opts.IsForeignKeyViolation = isForeignKeyViolation
+	opts.IsNotNullViolation = isNotNullViolation
 	opts.BooleanOperatorLookup = map[exp.BooleanOperation][]byte{
 		exp.EqOp:             []byte("="),
 		exp.NeqOp:            []byte("!="),
@@ -90,10 +115,70 @@ func DialectOptions() *goqu.SQLDialectOptions {
 	opts.ConflictFragment = []byte("")
 	opts.ConflictDoUpdateFragment = []byte("")
 	opts.ConflictDoNothingFragment = []byte("")
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{
+		exp.JSONGetOp:      []byte("JSON_QUERY"),
+		exp.JSONGetTextOp:  []byte("JSON_VALUE"),
+		exp.JSONPathOp:     []byte("JSON_QUERY"),
+		exp.JSONPathTextOp: []byte("JSON_VALUE"),
+	}
+	opts.SavepointFragment = []byte("SAVE TRANSACTION ")
+	opts.ReleaseSavepointFragment = []byte("")
+	opts.RollbackToSavepointFragment = []byte("ROLLBACK TRANSACTION ")
 
 	return opts
 }
 
+// used internally to quote the table name passed to mssql.CopyIn, which is used as is in the INSERT BULK statement
+func quoteIdentifier(name string) string {
+	return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+}
+
+// the error number of a transaction chosen as a deadlock victim
+const deadlockVictimErrorNumber = 1205
+
+// used by goqu.Database#WithTxRetry to retry transactions that were chosen as a deadlock victim
+func isRetryableError(err error) bool {
+	var mssqlErr mssql.Error
+	return errors.As(err, &mssqlErr) && mssqlErr.Number == deadlockVictimErrorNumber
+}
+
+// the error numbers used to classify errors, see goqu.IsUniqueViolation
+const (
+	// a violation of a PRIMARY KEY or UNIQUE constraint
+	uniqueConstraintErrorNumber = 2627
+	// a duplicate key in a unique index
+	uniqueIndexErrorNumber = 2601
+	// a statement conflicted with a FOREIGN KEY, REFERENCE or CHECK constraint
+	constraintConflictErrorNumber = 547
+	// a NULL inserted into a column that does not allow nulls
+	notNullErrorNumber = 515
+)
+
+func isUniqueViolation(err error) bool {
+	number, _, ok := errorNumber(err)
+	return ok && (number == uniqueConstraintErrorNumber || number == uniqueIndexErrorNumber)
+}
+
+// error 547 is also returned for CHECK constraints so the message is used to find foreign key violations
+func isForeignKeyViolation(err error) bool {
+	number, msg, ok := errorNumber(err)
+	return ok && number == constraintConflictErrorNumber &&
+		(strings.Contains(msg, "FOREIGN KEY constraint") || strings.Contains(msg, "REFERENCE constraint"))
+}
+
+func isNotNullViolation(err error) bool {
+	number, _, ok := errorNumber(err)
+	return ok && number == notNullErrorNumber
+}
+
+func errorNumber(err error) (number int32, msg string, ok bool) {
+	var mssqlErr mssql.Error
+	if !errors.As(err, &mssqlErr) {
+		return 0, "", false
+	}
+	return mssqlErr.Number, mssqlErr.Message, true
+}
+
 func init() {
 	goqu.RegisterDialect("sqlserver", DialectOptions())
 }
@@ -1,9 +1,16 @@
 package sqlserver_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	mssql "github.com/denisenkom/go-mssqldb"
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/dialect/sqlserver"
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/stretchr/testify/suite"
 )
@@ -55,6 +62,220 @@ func (sds *sqlserverDialectSuite) TestBitwiseOperations() {
 	)
 }
 
+func (sds *sqlserverDialectSuite) TestCopyInQuery() {
+	copyInQuery := sqlserver.DialectOptions().CopyInQuery
+	sds.Require().NotNil(copyInQuery)
+	sds.Equal(
+		mssql.CopyIn("[items]", mssql.BulkOptions{}, "id", "name"),
+		copyInQuery("", "items", []string{"id", "name"}),
+	)
+	sds.Equal(
+		mssql.CopyIn("[dbo].[odd]]name]", mssql.BulkOptions{}, "id"),
+		copyInQuery("dbo", "odd]name", []string{"id"}),
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestIsRetryableError() {
+	isRetryable := sqlserver.DialectOptions().IsRetryableError
+	sds.Require().NotNil(isRetryable)
+	sds.True(isRetryable(mssql.Error{Number: 1205}))
+	sds.True(isRetryable(fmt.Errorf("wrapped: %w", mssql.Error{Number: 1205})))
+	sds.False(isRetryable(mssql.Error{Number: 2627}))
+	sds.False(isRetryable(errors.New("deadlock")))
+}
+
+func (sds *sqlserverDialectSuite) TestConstraintViolations() {
+	opts := sqlserver.DialectOptions()
+	sds.True(opts.IsUniqueViolation(mssql.Error{Number: 2627}))
+	sds.True(opts.IsUniqueViolation(fmt.Errorf("wrapped: %w", mssql.Error{Number: 2601})))
+	sds.False(opts.IsUniqueViolation(mssql.Error{Number: 547}))
+	sds.True(opts.IsForeignKeyViolation(mssql.Error{
+		Number:  547,
+		Message: `The INSERT statement conflicted with the FOREIGN KEY constraint "fk_items_user".`,
+	}))
+	sds.True(opts.IsForeignKeyViolation(mssql.Error{
+		Number:  547,
+		Message: `The DELETE statement conflicted with the REFERENCE constraint "fk_items_user".`,
+	}))
+	sds.False(opts.IsForeignKeyViolation(mssql.Error{
+		Number:  547,
+		Message: `The INSERT statement conflicted with the CHECK constraint "ck_items_price".`,
+	}))
+	sds.True(opts.IsNotNullViolation(mssql.Error{Number: 515}))
+	sds.False(opts.IsNotNullViolation(errors.New("Cannot insert the value NULL")))
+
+	sds.True(goqu.IsUniqueViolation(mssql.Error{Number: 2627}))
+	sds.True(goqu.IsNotNullViolation(mssql.Error{Number: 515}))
+}
+
+func (sds *sqlserverDialectSuite) TestPaginate() {
+	ds := sds.GetDs("test").Order(goqu.C("a").Asc(), goqu.C("id").Asc())
+	cursor, err := ds.NextCursor(goqu.Record{"a": "b", "id": 10})
+	sds.NoError(err)
+	sds.assertSQL(
+		sqlTestCase{ds: ds.Paginate("", 10), sql: `SELECT  TOP (10) * FROM "test" ORDER BY "a" ASC, "id" ASC`},
+		sqlTestCase{
+			ds: ds.Paginate(cursor, 10),
+			sql: `SELECT  TOP (10) * FROM "test" WHERE (("a" > 'b') OR (("a" = 'b') AND ("id" > 10))) ` +
+				`ORDER BY "a" ASC, "id" ASC`,
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestSavepointFragments() {
+	opts := sqlserver.DialectOptions()
+	sds.Equal([]byte("SAVE TRANSACTION "), opts.SavepointFragment)
+	sds.Empty(opts.ReleaseSavepointFragment)
+	sds.Equal([]byte("ROLLBACK TRANSACTION "), opts.RollbackToSavepointFragment)
+}
+
+func (sds *sqlserverDialectSuite) TestArrayOperations() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Where(goqu.C("tags").Array().Contains([]string{"a"})),
+			err: "goqu: dialect does not support arrays [dialect=sqlserver]",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.Array([]int{1, 2})),
+			err: "goqu: dialect does not support arrays [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestJSONOperations() {
+	doc := goqu.C("doc")
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{ds: ds.Select(doc.JSON().Get("a")), sql: "SELECT JSON_QUERY(\"doc\", '$.a') FROM \"test\""},
+		sqlTestCase{ds: ds.Select(doc.JSON().GetText("a")), sql: "SELECT JSON_VALUE(\"doc\", '$.a') FROM \"test\""},
+		sqlTestCase{
+			ds:  ds.Select(doc.JSON().PathText("a", 0)),
+			sql: "SELECT JSON_VALUE(\"doc\", '$.a[0]') FROM \"test\"",
+		},
+		sqlTestCase{
+			ds:         ds.Prepared(true).Where(doc.JSON().GetText("a").Eq("b")),
+			sql:        "SELECT * FROM \"test\" WHERE (JSON_VALUE(\"doc\", @p1) = @p2)",
+			isPrepared: true,
+			args:       []interface{}{"$.a", "b"},
+		},
+		sqlTestCase{
+			ds:  ds.Where(doc.JSON().HasKey("a")),
+			err: "goqu: dialect does not support JSON operator 'has key' [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCompoundExpressions() {
+	ds1 := sds.GetDs("test").Select("a")
+	ds2 := sds.GetDs("test2").Select("b")
+	sds.assertSQL(
+		sqlTestCase{ds: ds1.Except(ds2), sql: "SELECT \"a\" FROM \"test\" EXCEPT (SELECT \"b\" FROM \"test2\")"},
+		sqlTestCase{ds: ds1.ExceptAll(ds2), err: "goqu: dialect does not support EXCEPT ALL clause [dialect=sqlserver]"},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestAggregateFilter() {
+	ds := sds.GetDs("test")
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Select(goqu.COUNT(goqu.Star()).Filter(goqu.C("a").Eq(1))),
+			sql: "SELECT COUNT(CASE  WHEN (\"a\" = 1) THEN 1 END) FROM \"test\"",
+		},
+		sqlTestCase{
+			ds:  ds.Select(goqu.Func("STRING_AGG", goqu.C("b"), ",").WithinGroup(goqu.C("b").Asc())),
+			sql: "SELECT STRING_AGG(\"b\", ',') WITHIN GROUP (ORDER BY \"b\" ASC) FROM \"test\"",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestUpsert() {
+	ds := goqu.Dialect("sqlserver").Insert("test").Rows(goqu.Record{"id": 1, "a": "b"})
+	sds.assertSQL(
+		sqlTestCase{
+			ds:  ds.Upsert("id"),
+			err: "goqu: dialect does not support references to the row proposed for insertion [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestMerge() {
+	ds := goqu.Dialect("sqlserver").
+		Merge(goqu.T("items").As("i")).
+		Using(goqu.T("new_items").As("n")).
+		On(goqu.I("i.id").Eq(goqu.I("n.id")))
+	sds.assertSQL(
+		sqlTestCase{
+			ds: ds.
+				WhenMatched(goqu.MergeUpdate(goqu.Record{"name": "a"})).
+				WhenNotMatched(goqu.MergeInsert(goqu.Record{"id": goqu.I("n.id"), "name": "a"})),
+			sql: `MERGE INTO "items" AS "i" USING "new_items" AS "n" ON ("i"."id" = "n"."id") ` +
+				`WHEN MATCHED THEN UPDATE SET "name"='a' WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("n"."id", 'a');`,
+		},
+		sqlTestCase{
+			ds: ds.Prepared(true).
+				WhenMatched(goqu.MergeUpdate(goqu.Record{"name": "a"})).
+				WhenNotMatched(goqu.MergeInsert(goqu.Record{"id": goqu.I("n.id"), "name": "a"})),
+			sql: `MERGE INTO "items" AS "i" USING "new_items" AS "n" ON ("i"."id" = "n"."id") ` +
+				`WHEN MATCHED THEN UPDATE SET "name"=@p1 WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("n"."id", @p2);`,
+			isPrepared: true,
+			args:       []interface{}{"a", "a"},
+		},
+		sqlTestCase{
+			ds: ds.WhenNotMatched(goqu.MergeInsert(nil)),
+			sql: `MERGE INTO "items" AS "i" USING "new_items" AS "n" ON ("i"."id" = "n"."id") ` +
+				`WHEN NOT MATCHED THEN INSERT DEFAULT VALUES;`,
+		},
+		sqlTestCase{
+			ds:  ds.WhenMatched(goqu.MergeDoNothing()),
+			err: "goqu: dialect does not support DO NOTHING in MERGE statements [dialect=sqlserver]",
+		},
+	)
+}
+
+func (sds *sqlserverDialectSuite) TestCopyFrom_Defaults() {
+	type item struct {
+		ID   int64  `db:"id" goqu:"defaultifempty"`
+		Name string `db:"name" goqu:"defaultifempty"`
+	}
+	mDB, mock, err := sqlmock.New()
+	sds.Require().NoError(err)
+	mock.ExpectBegin()
+	copyStmt := mock.ExpectPrepare(regexp.QuoteMeta(mssql.CopyIn("[items]", mssql.BulkOptions{}, "name")))
+	copyStmt.ExpectExec().WithArgs("a").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 1))
+	// sqlserver does not write DEFAULT VALUES for an INSERT without rows so it is added for rows that are all DEFAULT
+	mock.ExpectExec(`^INSERT INTO "items" DEFAULT VALUES$`).WithArgs().WillReturnResult(sqlmock.NewResult(0, 1))
+	copyStmt = mock.ExpectPrepare(regexp.QuoteMeta(mssql.CopyIn("[items]", mssql.BulkOptions{}, "name")))
+	copyStmt.ExpectExec().WithArgs("b").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	copied, err := goqu.New("sqlserver", mDB).Insert("items").
+		Rows([]item{{Name: "a"}, {}, {Name: "b"}}).
+		CopyFrom(context.Background())
+	sds.NoError(err)
+	sds.Equal(int64(3), copied)
+	sds.NoError(mock.ExpectationsWereMet())
+}
+
+func (sds *sqlserverDialectSuite) TestBatches() {
+	rows := make([]interface{}, 0, 2500)
+	for i := 0; i < 2500; i++ {
+		rows = append(rows, goqu.Record{"id": i})
+	}
+	ds := goqu.Dialect("sqlserver").Insert("items").Rows(rows...)
+	for _, prepared := range []bool{false, true} {
+		// a VALUES list can not contain more than 1000 rows even when the params are under MaxParams
+		batches, err := ds.Prepared(prepared).Batches()
+		sds.Require().NoError(err)
+		sds.Require().Len(batches, 3)
+		sds.Len(batches[0].GetClauses().Rows(), 1000)
+		sds.Len(batches[1].GetClauses().Rows(), 1000)
+		sds.Len(batches[2].GetClauses().Rows(), 500)
+	}
+}
+
 func TestDatasetAdapterSuite(t *testing.T) {
 	suite.Run(t, new(sqlserverDialectSuite))
 }
@@ -1,7 +1,9 @@
 package sqlserver_test
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -388,6 +390,51 @@ func (sst *sqlserverTest) TestInsert() {
 	sst.Len(newEntries, 4)
 }
 
+func (sst *sqlserverTest) TestCopyFrom() {
+	now := time.Now().Truncate(time.Second)
+	entries := []entry{
+		{Int: 11, Float: 1.100000, String: "1.100000", Time: now, Bool: false, Bytes: []byte("1.100000")},
+		{Int: 12, Float: 1.200000, String: "1.200000", Time: now, Bool: true, Bytes: []byte("1.200000")},
+		{Int: 13, Float: 1.300000, String: "1.300000", Time: now, Bool: false, Bytes: []byte("1.300000")},
+	}
+	copied, err := sst.db.Insert("entry").Rows(entries).CopyFrom(context.Background())
+	sst.NoError(err)
+	sst.Equal(int64(3), copied)
+
+	var newEntries []entry
+	sst.NoError(sst.db.From("entry").Where(goqu.C("int").In([]uint32{11, 12, 13})).Order(goqu.C("int").Asc()).ScanStructs(&newEntries))
+	sst.Len(newEntries, 3)
+	for i, e := range newEntries {
+		sst.True(e.ID > 0)
+		sst.Equal(entries[i].Int, e.Int)
+		sst.Equal(entries[i].Float, e.Float)
+		sst.Equal(entries[i].String, e.String)
+		sst.Equal(entries[i].Bool, e.Bool)
+		sst.Equal(entries[i].Bytes, e.Bytes)
+	}
+}
+
+func (sst *sqlserverTest) TestWithTx_Savepoint() {
+	sst.NoError(sst.db.WithTx(func(tx *goqu.TxDatabase) error {
+		e := entry{Int: 11, Float: 1.100000, String: "1.100000", Time: time.Now(), Bool: false, Bytes: []byte("1.100000")}
+		if _, err := tx.Insert("entry").Rows(e).Executor().Exec(); err != nil {
+			return err
+		}
+		sst.EqualError(tx.WithTx(func(tx *goqu.TxDatabase) error {
+			e := entry{Int: 12, Float: 1.200000, String: "1.200000", Time: time.Now(), Bool: true, Bytes: []byte("1.200000")}
+			if _, err := tx.Insert("entry").Rows(e).Executor().Exec(); err != nil {
+				return err
+			}
+			return errors.New("rollback to savepoint")
+		}), "rollback to savepoint")
+		return nil
+	}))
+
+	var ints []int
+	sst.NoError(sst.db.From("entry").Select("int").Where(goqu.C("int").In(11, 12)).ScanVals(&ints))
+	sst.Equal([]int{11}, ints)
+}
+
 func (sst *sqlserverTest) TestInsertReturningProducesError() {
 	ds := sst.db.From("entry")
 	now := time.Now()
@@ -0,0 +1,77 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/internal/errors"
+)
+
+type (
+	// The type of the errors returned by goqu when building or executing a statement. Use Error#Kind or errors.Is with
+	// one of the sentinel errors (e.g. ErrUnsupportedFeature) to classify an error instead of matching on the message.
+	//
+	//	var goquErr goqu.Error
+	//	if errors.As(err, &goquErr) && goquErr.Kind() == goqu.ErrorKindUnsupportedFeature {
+	//	    ...
+	//	}
+	Error = errors.Error
+	// The kind of an Error.
+	ErrorKind = errors.Kind
+)
+
+const (
+	// The error has not been classified.
+	ErrorKindUnknown = errors.KindUnknown
+	// The dialect does not support a feature used by the statement (e.g. RETURNING, MERGE).
+	ErrorKindUnsupportedFeature = errors.KindUnsupportedFeature
+	// A value could not be encoded into SQL.
+	ErrorKindEncode = errors.KindEncode
+	// The destination of a scan is not a supported type (e.g. scanning structs into a non slice).
+	ErrorKindScanMismatch = errors.KindScanMismatch
+	// A column returned by a query does not have a corresponding struct field.
+	ErrorKindMissingField = errors.KindMissingField
+)
+
+// Sentinel errors for each ErrorKind. Every Error matches the sentinel error of its kind when using errors.Is.
+//
+//	if errors.Is(err, goqu.ErrUnsupportedFeature) {
+//	    ...
+//	}
+var (
+	ErrUnsupportedFeature = errors.ErrUnsupportedFeature
+	ErrEncode             = errors.ErrEncode
+	ErrScanMismatch       = errors.ErrScanMismatch
+	ErrMissingField       = errors.ErrMissingField
+)
+
+// Returns true if the error returned by the driver is a unique constraint violation. The error is classified using
+// SQLDialectOptions.IsUniqueViolation of every registered dialect (e.g. postgres 23505, mysql 1062).
+func IsUniqueViolation(err error) bool {
+	return isDialectError(err, func(do *SQLDialectOptions) func(error) bool { return do.IsUniqueViolation })
+}
+
+// Returns true if the error returned by the driver is a foreign key constraint violation. The error is classified
+// using SQLDialectOptions.IsForeignKeyViolation of every registered dialect (e.g. postgres 23503, mysql 1452).
+func IsForeignKeyViolation(err error) bool {
+	return isDialectError(err, func(do *SQLDialectOptions) func(error) bool { return do.IsForeignKeyViolation })
+}
+
+// Returns true if the error returned by the driver is a not null constraint violation. The error is classified using
+// SQLDialectOptions.IsNotNullViolation of every registered dialect (e.g. postgres 23502, mysql 1048).
+func IsNotNullViolation(err error) bool {
+	return isDialectError(err, func(do *SQLDialectOptions) func(error) bool { return do.IsNotNullViolation })
+}
+
+// used internally to check the error against the classifier returned by classifier for each registered dialect. Each
+// driver returns its own error type so at most one dialect classifies the error.
+func isDialectError(err error, classifier func(do *SQLDialectOptions) func(error) bool) bool {
+	if err == nil {
+		return false
+	}
+	dialectsMu.RLock()
+	defer dialectsMu.RUnlock()
+	for _, d := range dialects {
+		if isErr := classifier(d.DialectOptions()); isErr != nil && isErr(err) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package goqu_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	errorsSuite struct {
+		suite.Suite
+	}
+	errTestConstraint struct {
+		constraint string
+	}
+)
+
+func (e errTestConstraint) Error() string {
+	return "constraint violation " + e.constraint
+}
+
+func (es *errorsSuite) SetupSuite() {
+	noReturn := goqu.DefaultDialectOptions()
+	noReturn.SupportsReturn = false
+	goqu.RegisterDialect("errors-no-return", noReturn)
+
+	constraints := goqu.DefaultDialectOptions()
+	isConstraint := func(constraint string) func(err error) bool {
+		return func(err error) bool {
+			var constraintErr errTestConstraint
+			return errors.As(err, &constraintErr) && constraintErr.constraint == constraint
+		}
+	}
+	constraints.IsUniqueViolation = isConstraint("unique")
+	constraints.IsForeignKeyViolation = isConstraint("foreign_key")
+	constraints.IsNotNullViolation = isConstraint("not_null")
+	goqu.RegisterDialect("errors-constraints", constraints)
+}
+
+func (es *errorsSuite) TearDownSuite() {
+	goqu.DeregisterDialect("errors-no-return")
+	goqu.DeregisterDialect("errors-constraints")
+}
+
+func (es *errorsSuite) assertKind(err error, kind goqu.ErrorKind, sentinel error) {
+	var goquErr goqu.Error
+	es.Require().True(errors.As(err, &goquErr), "expected a goqu.Error got %T", err)
+	es.Equal(kind, goquErr.Kind())
+	es.ErrorIs(err, sentinel)
+	es.ErrorIs(fmt.Errorf("wrapped: %w", err), sentinel)
+	for _, other := range []error{
+		goqu.ErrUnsupportedFeature,
+		goqu.ErrEncode,
+		goqu.ErrScanMismatch,
+		goqu.ErrMissingField,
+	} {
+		if other != sentinel {
+			es.NotErrorIs(err, other)
+		}
+	}
+}
+
+func (es *errorsSuite) TestErrorKind_UnsupportedFeature() {
+	_, _, err := goqu.Dialect("errors-no-return").Insert("items").
+		Rows(goqu.Record{"name": "Test"}).
+		Returning("id").
+		ToSQL()
+	es.EqualError(err, "goqu: dialect does not support RETURNING clause [dialect=errors-no-return]")
+	es.assertKind(err, goqu.ErrorKindUnsupportedFeature, goqu.ErrUnsupportedFeature)
+}
+
+func (es *errorsSuite) TestErrorKind_Encode() {
+	_, _, err := goqu.From("items").Where(goqu.C("name").Eq(struct{}{})).ToSQL()
+	es.EqualError(err, "goqu_encode_error: Unable to encode value {}")
+	es.assertKind(err, goqu.ErrorKindEncode, goqu.ErrEncode)
+}
+
+func (es *errorsSuite) TestErrorKind_ScanMismatchAndMissingField() {
+	mDB, mock, err := sqlmock.New()
+	es.NoError(err)
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("test1"))
+	mock.ExpectQuery(`SELECT "test" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"test"}).FromCSVString("test1"))
+
+	type item struct {
+		Name string `db:"name"`
+	}
+	db := goqu.New("mock", mDB)
+	var items []item
+	err = db.ScanStructs(items, `SELECT "name" FROM "items"`)
+	es.EqualError(err, "goqu: type must be a pointer to a slice when scanning into structs")
+	es.assertKind(err, goqu.ErrorKindScanMismatch, goqu.ErrScanMismatch)
+
+	err = db.ScanStructs(&items, `SELECT "test" FROM "items"`)
+	es.EqualError(err, `goqu: unable to find corresponding field to column "test" returned by query`)
+	es.assertKind(err, goqu.ErrorKindMissingField, goqu.ErrMissingField)
+	es.NoError(mock.ExpectationsWereMet())
+}
+
+func (es *errorsSuite) TestErrorKind_Unknown() {
+	_, _, err := goqu.Update("items").ToSQL()
+	es.EqualError(err, "goqu: no set values found when generating UPDATE sql")
+	var goquErr goqu.Error
+	es.Require().True(errors.As(err, &goquErr))
+	es.Equal(goqu.ErrorKindUnknown, goquErr.Kind())
+	es.False(errors.Is(err, goqu.ErrUnsupportedFeature))
+}
+
+func (es *errorsSuite) TestErrorKind_String() {
+	es.Equal("unknown", goqu.ErrorKindUnknown.String())
+	es.Equal("unsupported feature", goqu.ErrorKindUnsupportedFeature.String())
+	es.Equal("encode", goqu.ErrorKindEncode.String())
+	es.Equal("scan mismatch", goqu.ErrorKindScanMismatch.String())
+	es.Equal("missing field", goqu.ErrorKindMissingField.String())
+}
+
+func (es *errorsSuite) TestConstraintViolations() {
+	unique := fmt.Errorf("insert failed: %w", errTestConstraint{constraint: "unique"})
+	foreignKey := errTestConstraint{constraint: "foreign_key"}
+	notNull := errTestConstraint{constraint: "not_null"}
+
+	es.True(goqu.IsUniqueViolation(unique))
+	es.False(goqu.IsUniqueViolation(foreignKey))
+	es.True(goqu.IsForeignKeyViolation(foreignKey))
+	es.False(goqu.IsForeignKeyViolation(notNull))
+	es.True(goqu.IsNotNullViolation(notNull))
+	es.False(goqu.IsNotNullViolation(unique))
+
+	for _, err := range []error{nil, errors.New("unique"), errTestConstraint{constraint: "check"}} {
+		es.False(goqu.IsUniqueViolation(err))
+		es.False(goqu.IsForeignKeyViolation(err))
+		es.False(goqu.IsNotNullViolation(err))
+	}
+}
+
+func TestErrorsSuite(t *testing.T) {
+	suite.Run(t, new(errorsSuite))
+}
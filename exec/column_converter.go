@@ -0,0 +1,38 @@
+package exec
+
+import "strings"
+
+// Converts a value scanned from a column into the value stored in a record by RecordScanner#ScanRecord.
+//
+// databaseTypeName: The database type of the column as returned by sql.ColumnType#DatabaseTypeName (e.g. VARCHAR)
+//
+// val: The value returned by the driver, nil if the value is NULL.
+type ColumnConverter func(databaseTypeName string, val interface{}) (interface{}, error)
+
+// The database types that are converted from []byte to a string by ConvertColumnValue.
+var textColumnTypes = map[string]bool{
+	"CLOB":    true,
+	"DECIMAL": true,
+	"ENUM":    true,
+	"JSON":    true,
+	"JSONB":   true,
+	"NUMERIC": true,
+	"SET":     true,
+	"UUID":    true,
+	"XML":     true,
+}
+
+// The default ColumnConverter. Converts []byte values of text columns (e.g. CHAR, VARCHAR, TEXT, JSON and DECIMAL) to a
+// string, all other values are returned as is.
+func ConvertColumnValue(databaseTypeName string, val interface{}) (interface{}, error) {
+	if b, ok := val.([]byte); ok && IsTextColumnType(databaseTypeName) {
+		return string(b), nil
+	}
+	return val, nil
+}
+
+// Returns true if the database type is a text type that ConvertColumnValue converts to a string.
+func IsTextColumnType(databaseTypeName string) bool {
+	t := strings.ToUpper(databaseTypeName)
+	return strings.Contains(t, "CHAR") || strings.Contains(t, "TEXT") || textColumnTypes[t]
+}
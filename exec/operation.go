@@ -0,0 +1,27 @@
+package exec
+
+import "context"
+
+// The operations set on the context of statements executed by a dataset. See OperationFromContext.
+const (
+	OperationSelect   = "SELECT"
+	OperationInsert   = "INSERT"
+	OperationUpdate   = "UPDATE"
+	OperationDelete   = "DELETE"
+	OperationTruncate = "TRUNCATE"
+	OperationMerge    = "MERGE"
+)
+
+type operationCtxKey struct{}
+
+// Returns a copy of the context with the operation (e.g. SELECT, INSERT) of the statement being executed.
+func ContextWithOperation(ctx context.Context, op string) context.Context {
+	return context.WithValue(ctx, operationCtxKey{}, op)
+}
+
+// Returns the operation set by ContextWithOperation or an empty string if the statement was not executed by a dataset.
+// This can be used by a goqu.QueryHook to determine the type of dataset that executed the statement.
+func OperationFromContext(ctx context.Context) string {
+	op, _ := ctx.Value(operationCtxKey{}).(string)
+	return op
+}
@@ -5,25 +5,30 @@ import (
 	gsql "database/sql"
 	"reflect"
 
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/doug-martin/goqu/v9/internal/errors"
 	"github.com/doug-martin/goqu/v9/internal/util"
 )
 
 type (
 	QueryExecutor struct {
-		de    DbExecutor
-		err   error
-		query string
-		args  []interface{}
+		de        DbExecutor
+		err       error
+		query     string
+		args      []interface{}
+		operation string
+		redacted  *RedactedSQL
+		converter ColumnConverter
+		scanMode  ScanMode
 	}
 )
 
 var (
-	errUnsupportedScanStructType  = errors.New("type must be a pointer to a struct when scanning into a struct")
-	errUnsupportedScanStructsType = errors.New("type must be a pointer to a slice when scanning into structs")
-	errUnsupportedScanValsType    = errors.New("type must be a pointer to a slice when scanning into vals")
-	errScanValPointer             = errors.New("type must be a pointer when scanning into val")
-	errScanValNonSlice            = errors.New("type cannot be a pointer to a slice when scanning into val")
+	errUnsupportedScanStructType  = errors.NewScanMismatchError("type must be a pointer to a struct when scanning into a struct")
+	errUnsupportedScanStructsType = errors.NewScanMismatchError("type must be a pointer to a slice when scanning into structs")
+	errUnsupportedScanValsType    = errors.NewScanMismatchError("type must be a pointer to a slice when scanning into vals")
+	errScanValPointer             = errors.NewScanMismatchError("type must be a pointer when scanning into val")
+	errScanValNonSlice            = errors.NewScanMismatchError("type cannot be a pointer to a slice when scanning into val")
 )
 
 func newQueryExecutor(de DbExecutor, err error, query string, args ...interface{}) QueryExecutor {
@@ -34,6 +39,27 @@ func (q QueryExecutor) ToSQL() (sql string, args []interface{}, err error) {
 	return q.query, q.args, q.err
 }
 
+// Returns a copy of the executor that sets the operation on the context of executed statements. See
+// OperationFromContext.
+func (q QueryExecutor) WithOperation(op string) QueryExecutor {
+	q.operation = op
+	return q
+}
+
+// Returns a copy of the executor that uses the converter to convert the values scanned by ScanRecords, ScanMaps and
+// RecordScanner#ScanRecord. If the converter is nil ConvertColumnValue is used.
+func (q QueryExecutor) WithColumnConverter(converter ColumnConverter) QueryExecutor {
+	q.converter = converter
+	return q
+}
+
+// Returns a copy of the executor that uses the mode when scanning rows into structs. If the mode is ScanModeDefault the
+// mode set with SetDefaultScanMode is used.
+func (q QueryExecutor) WithScanMode(mode ScanMode) QueryExecutor {
+	q.scanMode = mode
+	return q
+}
+
 func (q QueryExecutor) Exec() (gsql.Result, error) {
 	return q.ExecContext(context.Background())
 }
@@ -42,7 +68,7 @@ func (q QueryExecutor) ExecContext(ctx context.Context) (gsql.Result, error) {
 	if q.err != nil {
 		return nil, q.err
 	}
-	return q.de.ExecContext(ctx, q.query, q.args...)
+	return q.de.ExecContext(q.operationContext(ctx), q.query, q.args...)
 }
 
 func (q QueryExecutor) Query() (*gsql.Rows, error) {
@@ -53,7 +79,17 @@ func (q QueryExecutor) QueryContext(ctx context.Context) (*gsql.Rows, error) {
 	if q.err != nil {
 		return nil, q.err
 	}
-	return q.de.QueryContext(ctx, q.query, q.args...)
+	return q.de.QueryContext(q.operationContext(ctx), q.query, q.args...)
+}
+
+func (q QueryExecutor) operationContext(ctx context.Context) context.Context {
+	if q.operation != "" {
+		ctx = ContextWithOperation(ctx, q.operation)
+	}
+	if q.redacted != nil {
+		ctx = ContextWithRedactedSQL(ctx, q.query, *q.redacted)
+	}
+	return ctx
 }
 
 // This will execute the SQL and append results to the slice
@@ -87,6 +123,23 @@ func (q QueryExecutor) ScanStructsContext(ctx context.Context, i interface{}) er
 	return scanner.ScanStructs(i)
 }
 
+// This will execute the SQL and append the structs to the slice like ScanStructsContext. The countCol column of each
+// row is not scanned into the struct, instead the value of the column is returned. This is used to scan a page that
+// selects the total count using a window function (e.g. COUNT(*) OVER ()). If no rows are returned the count is 0.
+//
+// i: A pointer to a slice of structs
+//
+// countCol: The name of the column that contains the count
+func (q QueryExecutor) ScanStructsAndCountContext(ctx context.Context, i interface{}, countCol string) (int64, error) {
+	rows, err := q.QueryContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	s := q.newScanner(rows)
+	defer func() { _ = s.Close() }()
+	return s.scanStructsAndCount(i, countCol)
+}
+
 // This will execute the SQL and fill out the struct with the fields returned.
 // This method returns a boolean value that is false if no record was found
 //
@@ -238,6 +291,40 @@ func (q QueryExecutor) ScanValContext(ctx context.Context, i interface{}) (bool,
 	return false, scanner.Err()
 }
 
+// This will execute the SQL and return a record for each row keyed by the column names. This is useful when the
+// columns are not known at compile time. The type of each value is determined by the driver and the type of the column,
+// []byte values of text columns are converted to a string (see ConvertColumnValue and WithColumnConverter).
+//
+//	records, err := db.From("test").Executor().ScanRecords(ctx)
+//	if err != nil{
+//	    panic(err.Error()
+//	}
+//	for _, r := range records {
+//	    fmt.Println(r["name"])
+//	}
+func (q QueryExecutor) ScanRecords(ctx context.Context) ([]exp.Record, error) {
+	rows, err := q.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := q.newScanner(rows)
+	defer func() { _ = s.Close() }()
+	return s.scanRecords()
+}
+
+// This will execute the SQL and return a map for each row keyed by the column names. See ScanRecords.
+func (q QueryExecutor) ScanMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	records, err := q.ScanRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maps := make([]map[string]interface{}, 0, len(records))
+	for _, r := range records {
+		maps = append(maps, r)
+	}
+	return maps, nil
+}
+
 // Scanner will return a Scanner that can be used for manually scanning rows.
 func (q QueryExecutor) Scanner() (Scanner, error) {
 	return q.ScannerContext(context.Background())
@@ -249,5 +336,9 @@ func (q QueryExecutor) ScannerContext(ctx context.Context) (Scanner, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewScanner(rows), nil
+	return q.newScanner(rows), nil
+}
+
+func (q QueryExecutor) newScanner(rows *gsql.Rows) *scanner {
+	return &scanner{rows: rows, converter: q.converter, mode: q.scanMode}
 }
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -76,6 +77,35 @@ func (qes *queryExecutorSuite) TestToSQL() {
 	qes.Empty(args)
 }
 
+type operationDbExecutor struct {
+	operations []string
+}
+
+func (ode *operationDbExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ode.operations = append(ode.operations, OperationFromContext(ctx))
+	return sqlmock.NewResult(0, 0), nil
+}
+
+func (ode *operationDbExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ode.operations = append(ode.operations, OperationFromContext(ctx))
+	return nil, fmt.Errorf("query error")
+}
+
+func (qes *queryExecutorSuite) TestWithOperation() {
+	ctx := context.Background()
+	de := new(operationDbExecutor)
+	e := newQueryExecutor(de, nil, `DELETE FROM "items"`)
+	_, err := e.ExecContext(ctx)
+	qes.NoError(err)
+	_, err = e.WithOperation(OperationDelete).ExecContext(ctx)
+	qes.NoError(err)
+	_, err = e.WithOperation(OperationSelect).QueryContext(ctx)
+	qes.EqualError(err, "query error")
+	_, err = e.QueryContext(ContextWithOperation(ctx, OperationUpdate))
+	qes.EqualError(err, "query error")
+	qes.Equal([]string{"", OperationDelete, OperationSelect, OperationUpdate}, de.operations)
+}
+
 func (qes *queryExecutorSuite) TestScanStructs_withTaggedFields() {
 	type StructWithTags struct {
 		Address string `db:"address"`
@@ -613,6 +643,99 @@ func (qes *queryExecutorSuite) TestScanStructsContext_withPointerFields() {
 	}, items)
 }
 
+func (qes *queryExecutorSuite) TestScanStructsAndCountContext() {
+	type StructWithTags struct {
+		Address string `db:"address"`
+		Name    string `db:"name"`
+	}
+
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	qes.NoError(err)
+
+	query := `SELECT "address", "name", COUNT(*) OVER () AS "total" FROM "items" LIMIT 2`
+	mock.ExpectQuery(`SELECT "address", "name", COUNT\(\*\) OVER \(\) AS "total" FROM "items" LIMIT 2`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name", "total"}).
+			AddRow(testAddr1, testName1, 5).
+			AddRow(testAddr2, testName2, 5),
+		)
+	mock.ExpectQuery(`SELECT "address", "name", COUNT\(\*\) OVER \(\) AS "total" FROM "items" LIMIT 2`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name", "total"}))
+	mock.ExpectQuery(`SELECT "address", "name", COUNT\(\*\) OVER \(\) AS "total" FROM "items" LIMIT 2`).
+		WithArgs().
+		WillReturnError(fmt.Errorf("queryExecutor error"))
+
+	e := newQueryExecutor(db, nil, query)
+
+	var items []StructWithTags
+	count, err := e.ScanStructsAndCountContext(ctx, &items, "total")
+	qes.NoError(err)
+	qes.Equal(int64(5), count)
+	qes.Equal([]StructWithTags{
+		{Address: testAddr1, Name: testName1},
+		{Address: testAddr2, Name: testName2},
+	}, items)
+
+	items = nil
+	count, err = e.ScanStructsAndCountContext(ctx, &items, "total")
+	qes.NoError(err)
+	qes.Equal(int64(0), count)
+	qes.Empty(items)
+
+	_, err = e.ScanStructsAndCountContext(ctx, &items, "total")
+	qes.EqualError(err, "queryExecutor error")
+}
+
+func (qes *queryExecutorSuite) TestScanRecords() {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	qes.NoError(err)
+
+	newRows := func() *sqlmock.Rows {
+		return sqlmock.NewRowsWithColumnDefinition(
+			sqlmock.NewColumn("address").OfType("TEXT", ""),
+			sqlmock.NewColumn("name").OfType("VARCHAR", ""),
+		).
+			AddRow([]byte(testAddr1), testName1).
+			AddRow([]byte(testAddr2), testName2)
+	}
+	mock.ExpectQuery(`SELECT \* FROM "items"`).WithArgs().WillReturnRows(newRows())
+	mock.ExpectQuery(`SELECT \* FROM "items"`).WithArgs().WillReturnRows(newRows())
+	mock.ExpectQuery(`SELECT \* FROM "items"`).WithArgs().WillReturnRows(newRows())
+	mock.ExpectQuery(`SELECT \* FROM "items"`).WithArgs().WillReturnError(fmt.Errorf("queryExecutor error"))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	records, err := e.ScanRecords(ctx)
+	qes.NoError(err)
+	qes.Equal([]exp.Record{
+		{"address": testAddr1, "name": testName1},
+		{"address": testAddr2, "name": testName2},
+	}, records)
+
+	maps, err := e.ScanMaps(ctx)
+	qes.NoError(err)
+	qes.Equal([]map[string]interface{}{
+		{"address": testAddr1, "name": testName1},
+		{"address": testAddr2, "name": testName2},
+	}, maps)
+
+	records, err = e.WithColumnConverter(func(databaseTypeName string, val interface{}) (interface{}, error) {
+		return databaseTypeName, nil
+	}).ScanRecords(ctx)
+	qes.NoError(err)
+	qes.Equal([]exp.Record{
+		{"address": "TEXT", "name": "VARCHAR"},
+		{"address": "TEXT", "name": "VARCHAR"},
+	}, records)
+
+	_, err = e.ScanMaps(ctx)
+	qes.EqualError(err, "queryExecutor error")
+	qes.NoError(mock.ExpectationsWereMet())
+}
+
 func (qes *queryExecutorSuite) TestScanStructsContext_pointers() {
 	type StructWithTags struct {
 		Address string `db:"address"`
@@ -32,5 +32,10 @@ func (qs *querySupport) FromSQL(query string, args ...interface{}) QueryExecutor
 
 func (qs *querySupport) FromSQLBuilder(b sb.SQLBuilder) QueryExecutor {
 	query, args, err := b.ToSQL()
-	return newQueryExecutor(qs.de, err, query, args...)
+	qe := newQueryExecutor(qs.de, err, query, args...)
+	if err == nil && b.HasSensitive() {
+		redactedQuery, redactedArgs, _ := b.ToRedactedSQL()
+		qe.redacted = &RedactedSQL{Query: redactedQuery, Args: redactedArgs}
+	}
+	return qe
 }
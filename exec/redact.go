@@ -0,0 +1,48 @@
+package exec
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+type (
+	// The sql and args of a statement with every sensitive value replaced with exp.RedactedValue.
+	RedactedSQL struct {
+		Query string
+		Args  []interface{}
+	}
+	redactedSQLCtxKey struct{}
+	redactedSQLCtx    struct {
+		query    string
+		redacted RedactedSQL
+	}
+)
+
+// Returns a copy of the context with the redacted version of the query.
+func ContextWithRedactedSQL(ctx context.Context, query string, redacted RedactedSQL) context.Context {
+	return context.WithValue(ctx, redactedSQLCtxKey{}, redactedSQLCtx{query: query, redacted: redacted})
+}
+
+// Returns the query and args with every sensitive value replaced with exp.RedactedValue. If the query was generated
+// by a dataset the redacted version set by ContextWithRedactedSQL is returned, otherwise any args that match a
+// registered exp.SensitiveValueMatcher are replaced.
+func RedactSQL(ctx context.Context, query string, args []interface{}) RedactedSQL {
+	if r, ok := ctx.Value(redactedSQLCtxKey{}).(redactedSQLCtx); ok && r.query == query {
+		return r.redacted
+	}
+	var redactedArgs []interface{}
+	for i, arg := range args {
+		if exp.IsSensitiveValue(arg) {
+			if redactedArgs == nil {
+				redactedArgs = make([]interface{}, len(args))
+				copy(redactedArgs, args)
+			}
+			redactedArgs[i] = exp.RedactedValue
+		}
+	}
+	if redactedArgs == nil {
+		redactedArgs = args
+	}
+	return RedactedSQL{Query: query, Args: redactedArgs}
+}
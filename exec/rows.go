@@ -0,0 +1,175 @@
+package exec
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/doug-martin/goqu/v9/internal/util"
+)
+
+// Rows is a typed iterator over the results of a query. If T is a struct (or a pointer to a struct) each row is
+// scanned using Scanner#ScanStruct, otherwise each row is scanned using Scanner#ScanVal.
+//
+//	rows, err := exec.QueryRows[MyStruct](ctx, db.From("test").Executor())
+//	if err != nil{
+//	    panic(err.Error())
+//	}
+//	defer rows.Close()
+//	for rows.Next() {
+//	    myStruct, err := rows.Scan()
+//	    ...
+//	}
+//	if err := rows.Err(); err != nil{
+//	    panic(err.Error())
+//	}
+type Rows[T any] struct {
+	scanner  Scanner
+	rowType  reflect.Type
+	isStruct bool
+}
+
+// NewRows returns a Rows iterator that scans each row of the Scanner into a T.
+func NewRows[T any](scanner Scanner) *Rows[T] {
+	rowType := reflect.TypeOf((*T)(nil)).Elem()
+	return &Rows[T]{
+		scanner:  scanner,
+		rowType:  rowType,
+		isStruct: util.IsStructScanType(rowType),
+	}
+}
+
+// QueryRows executes the query and returns a Rows iterator for the results. The caller is responsible for closing
+// the returned Rows.
+func QueryRows[T any](ctx context.Context, q QueryExecutor) (*Rows[T], error) {
+	scanner, err := q.ScannerContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewRows[T](scanner), nil
+}
+
+// ScanAll executes the query and scans every row into a slice of T.
+func ScanAll[T any](ctx context.Context, q QueryExecutor) ([]T, error) {
+	rows, err := QueryRows[T](ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	var results []T
+	for rows.Next() {
+		row, err := rows.Scan()
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, row)
+	}
+	return results, rows.Err()
+}
+
+// ScanOne executes the query and scans the first row into a T. This method returns false if no record was found.
+func ScanOne[T any](ctx context.Context, q QueryExecutor) (T, bool, error) {
+	var zero T
+	rows, err := QueryRows[T](ctx, q)
+	if err != nil {
+		return zero, false, err
+	}
+	defer func() { _ = rows.Close() }()
+	if rows.Next() {
+		row, err := rows.Scan()
+		if err != nil {
+			return zero, false, err
+		}
+		return row, true, rows.Err()
+	}
+	return zero, false, rows.Err()
+}
+
+// Each executes the query and calls fn with each row as it is scanned, only one row is held in memory at a time. If fn
+// returns an error iteration stops, the rows are closed and the error is returned.
+func Each[T any](ctx context.Context, q QueryExecutor, fn func(row T) error) error {
+	rows, err := QueryRows[T](ctx, q)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		row, err := rows.Scan()
+		if err != nil {
+			return err
+		}
+		if fnErr := fn(row); fnErr != nil {
+			return fnErr
+		}
+	}
+	return rows.Err()
+}
+
+// Stream returns an iterator that executes the query and yields each row as it is scanned, only one row is held in
+// memory at a time. If the query, a scan or the iteration fails the error is yielded with a zero T and iteration
+// stops. The rows are closed when iteration completes or the caller stops iterating early.
+//
+// With go1.23 or later the iterator can be used in a range statement.
+//
+//	for myStruct, err := range exec.Stream[MyStruct](ctx, db.From("test").Executor()) {
+//	    if err != nil {
+//	        panic(err.Error())
+//	    }
+//	    ...
+//	}
+func Stream[T any](ctx context.Context, q QueryExecutor) func(yield func(row T, err error) bool) {
+	return func(yield func(row T, err error) bool) {
+		var zero T
+		rows, err := QueryRows[T](ctx, q)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer func() { _ = rows.Close() }()
+		for rows.Next() {
+			row, err := rows.Scan()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(row, nil) {
+				return
+			}
+		}
+		if err = rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+// Next prepares the next row for scanning. See sql.Rows#Next for more information.
+func (r *Rows[T]) Next() bool {
+	return r.scanner.Next()
+}
+
+// Scan scans the current row into a new T.
+func (r *Rows[T]) Scan() (T, error) {
+	var row T
+	if !r.isStruct {
+		err := r.scanner.ScanVal(&row)
+		return row, err
+	}
+	if util.IsPointer(r.rowType.Kind()) {
+		val := reflect.New(r.rowType.Elem())
+		if err := r.scanner.ScanStruct(val.Interface()); err != nil {
+			return row, err
+		}
+		return val.Interface().(T), nil
+	}
+	err := r.scanner.ScanStruct(&row)
+	return row, err
+}
+
+// Err returns the error, if any, that was encountered during iteration. See sql.Rows#Err for more information.
+func (r *Rows[T]) Err() error {
+	return r.scanner.Err()
+}
+
+// Close closes the underlying rows, preventing further enumeration. See sql.Rows#Close for more information.
+func (r *Rows[T]) Close() error {
+	return r.scanner.Close()
+}
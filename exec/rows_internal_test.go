@@ -0,0 +1,363 @@
+package exec
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type rowsSuite struct {
+	suite.Suite
+}
+
+type rowsTestItem struct {
+	Address string `db:"address"`
+	Name    string `db:"name"`
+}
+
+func (rs *rowsSuite) TestScanAll_structs() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			AddRow(testAddr1, testName1).
+			AddRow(testAddr2, testName2))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	items, err := ScanAll[rowsTestItem](context.Background(), e)
+	rs.NoError(err)
+	rs.Equal([]rowsTestItem{
+		{Address: testAddr1, Name: testName1},
+		{Address: testAddr2, Name: testName2},
+	}, items)
+	rs.NoError(mock.ExpectationsWereMet())
+}
+
+func (rs *rowsSuite) TestScanAll_structPointers() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			AddRow(testAddr1, testName1).
+			AddRow(testAddr2, testName2))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	items, err := ScanAll[*rowsTestItem](context.Background(), e)
+	rs.NoError(err)
+	rs.Equal([]*rowsTestItem{
+		{Address: testAddr1, Name: testName1},
+		{Address: testAddr2, Name: testName2},
+	}, items)
+}
+
+func (rs *rowsSuite) TestScanAll_vals() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).
+			AddRow(testName1).
+			AddRow(testName2))
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).
+			AddRow(testName1).
+			AddRow(nil))
+
+	e := newQueryExecutor(db, nil, `SELECT "name" FROM "items"`)
+
+	names, err := ScanAll[string](context.Background(), e)
+	rs.NoError(err)
+	rs.Equal([]string{testName1, testName2}, names)
+
+	nullNames, err := ScanAll[sql.NullString](context.Background(), e)
+	rs.NoError(err)
+	rs.Equal([]sql.NullString{{String: testName1, Valid: true}, {}}, nullNames)
+}
+
+func (rs *rowsSuite) TestScanAll_noRows() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	items, err := ScanAll[rowsTestItem](context.Background(), e)
+	rs.NoError(err)
+	rs.Empty(items)
+}
+
+func (rs *rowsSuite) TestScanAll_withError() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnError(fmt.Errorf("query error"))
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "other"}).AddRow(testAddr1, testName1))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+	items, err := ScanAll[rowsTestItem](context.Background(), e)
+	rs.EqualError(err, "query error")
+	rs.Nil(items)
+
+	items, err = ScanAll[rowsTestItem](context.Background(), e)
+	rs.EqualError(err, `goqu: unable to find corresponding field to column "other" returned by query`)
+	rs.Nil(items)
+
+	e = newQueryExecutor(db, fmt.Errorf("builder error"), `SELECT * FROM "items"`)
+	items, err = ScanAll[rowsTestItem](context.Background(), e)
+	rs.EqualError(err, "builder error")
+	rs.Nil(items)
+}
+
+func (rs *rowsSuite) TestScanOne() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).AddRow(testAddr1, testName1))
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}))
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnError(fmt.Errorf("query error"))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	item, found, err := ScanOne[rowsTestItem](context.Background(), e)
+	rs.NoError(err)
+	rs.True(found)
+	rs.Equal(rowsTestItem{Address: testAddr1, Name: testName1}, item)
+
+	item, found, err = ScanOne[rowsTestItem](context.Background(), e)
+	rs.NoError(err)
+	rs.False(found)
+	rs.Equal(rowsTestItem{}, item)
+
+	item, found, err = ScanOne[rowsTestItem](context.Background(), e)
+	rs.EqualError(err, "query error")
+	rs.False(found)
+	rs.Equal(rowsTestItem{}, item)
+}
+
+func (rs *rowsSuite) TestScanOne_val() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT "age" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"age"}).AddRow(testAge1))
+
+	e := newQueryExecutor(db, nil, `SELECT "age" FROM "items"`)
+
+	age, found, err := ScanOne[int64](context.Background(), e)
+	rs.NoError(err)
+	rs.True(found)
+	rs.Equal(testAge1, age)
+}
+
+func (rs *rowsSuite) TestQueryRows() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			AddRow(testAddr1, testName1).
+			AddRow(testAddr2, testName2)).
+		RowsWillBeClosed()
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	rows, err := QueryRows[rowsTestItem](context.Background(), e)
+	rs.NoError(err)
+
+	rs.True(rows.Next())
+	item, err := rows.Scan()
+	rs.NoError(err)
+	rs.Equal(rowsTestItem{Address: testAddr1, Name: testName1}, item)
+
+	rs.True(rows.Next())
+	item, err = rows.Scan()
+	rs.NoError(err)
+	rs.Equal(rowsTestItem{Address: testAddr2, Name: testName2}, item)
+
+	rs.False(rows.Next())
+	rs.NoError(rows.Err())
+	rs.NoError(rows.Close())
+	rs.NoError(mock.ExpectationsWereMet())
+}
+
+func (rs *rowsSuite) TestEach() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			AddRow(testAddr1, testName1).
+			AddRow(testAddr2, testName2)).
+		RowsWillBeClosed()
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	var items []rowsTestItem
+	rs.NoError(Each(context.Background(), e, func(item rowsTestItem) error {
+		items = append(items, item)
+		return nil
+	}))
+	rs.Equal([]rowsTestItem{
+		{Address: testAddr1, Name: testName1},
+		{Address: testAddr2, Name: testName2},
+	}, items)
+	rs.NoError(mock.ExpectationsWereMet())
+}
+
+func (rs *rowsSuite) TestEach_stopsOnError() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			AddRow(testAddr1, testName1).
+			AddRow(testAddr2, testName2)).
+		RowsWillBeClosed()
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "other"}).AddRow(testAddr1, testName1)).
+		RowsWillBeClosed()
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnError(fmt.Errorf("query error"))
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	calls := 0
+	rs.EqualError(Each(context.Background(), e, func(item rowsTestItem) error {
+		calls++
+		return fmt.Errorf("callback error")
+	}), "callback error")
+	rs.Equal(1, calls)
+
+	rs.EqualError(Each(context.Background(), e, func(item rowsTestItem) error {
+		calls++
+		return nil
+	}), `goqu: unable to find corresponding field to column "other" returned by query`)
+	rs.Equal(1, calls)
+
+	rs.EqualError(Each(context.Background(), e, func(item rowsTestItem) error {
+		calls++
+		return nil
+	}), "query error")
+	rs.Equal(1, calls)
+	rs.NoError(mock.ExpectationsWereMet())
+}
+
+func (rs *rowsSuite) TestStream() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).
+			AddRow(testName1).
+			AddRow(testName2)).
+		RowsWillBeClosed()
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).
+			AddRow(testName1).
+			AddRow(testName2)).
+		RowsWillBeClosed()
+	mock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).
+			AddRow(testName1).
+			RowError(0, fmt.Errorf("row error"))).
+		RowsWillBeClosed()
+
+	e := newQueryExecutor(db, nil, `SELECT "name" FROM "items"`)
+
+	var names []string
+	Stream[string](context.Background(), e)(func(name string, err error) bool {
+		rs.NoError(err)
+		names = append(names, name)
+		return true
+	})
+	rs.Equal([]string{testName1, testName2}, names)
+
+	// stopping early closes the rows
+	names = nil
+	Stream[string](context.Background(), e)(func(name string, err error) bool {
+		rs.NoError(err)
+		names = append(names, name)
+		return false
+	})
+	rs.Equal([]string{testName1}, names)
+
+	var errs []error
+	Stream[string](context.Background(), e)(func(name string, err error) bool {
+		rs.Empty(name)
+		errs = append(errs, err)
+		return true
+	})
+	rs.Len(errs, 1)
+	rs.EqualError(errs[0], "row error")
+	rs.NoError(mock.ExpectationsWereMet())
+}
+
+func (rs *rowsSuite) TestStream_withError() {
+	db, mock, err := sqlmock.New()
+	rs.NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnError(fmt.Errorf("query error"))
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "other"}).
+			AddRow(testAddr1, testName1).
+			AddRow(testAddr2, testName2)).
+		RowsWillBeClosed()
+
+	e := newQueryExecutor(db, nil, `SELECT * FROM "items"`)
+
+	for _, expected := range []string{
+		"query error",
+		`goqu: unable to find corresponding field to column "other" returned by query`,
+	} {
+		var errs []error
+		Stream[*rowsTestItem](context.Background(), e)(func(item *rowsTestItem, err error) bool {
+			rs.Nil(item)
+			errs = append(errs, err)
+			return true
+		})
+		rs.Len(errs, 1)
+		rs.EqualError(errs[0], expected)
+	}
+	rs.NoError(mock.ExpectationsWereMet())
+}
+
+func TestRowsSuite(t *testing.T) {
+	suite.Run(t, new(rowsSuite))
+}
@@ -0,0 +1,45 @@
+package exec
+
+// The behavior of Scanner#ScanStruct when the columns returned by a query do not match the fields of the struct.
+type ScanMode int
+
+const (
+	// zero value that defers to the mode set with SetDefaultScanMode
+	ScanModeDefault ScanMode = iota
+	// Returns an error if a column returned by the query does not have a corresponding struct field. This is the
+	// default unless changed with SetDefaultScanMode.
+	ScanModeMatchColumns
+	// Ignores columns returned by the query that do not have a corresponding struct field (e.g. SELECT * after a
+	// column has been added to the table).
+	ScanModeIgnoreUnknownColumns
+	// Returns an error if a column returned by the query does not have a corresponding struct field or if a struct
+	// field is not populated by a column returned by the query.
+	ScanModeStrict
+)
+
+// defaultScanMode is controlled by SetDefaultScanMode
+var defaultScanMode = ScanModeMatchColumns
+
+// SetDefaultScanMode controls the ScanMode used by scanners that do not have a mode set (see
+// QueryExecutor#WithScanMode). Setting ScanModeDefault restores ScanModeMatchColumns.
+func SetDefaultScanMode(mode ScanMode) {
+	if mode == ScanModeDefault {
+		mode = ScanModeMatchColumns
+	}
+	defaultScanMode = mode
+}
+
+// resolves ScanModeDefault to the default scan mode
+func (m ScanMode) resolve() ScanMode {
+	if m == ScanModeDefault {
+		return defaultScanMode
+	}
+	return m
+}
+
+// used to discard the value of a column that does not have a corresponding struct field
+type discardScanner struct{}
+
+func (discardScanner) Scan(interface{}) error {
+	return nil
+}
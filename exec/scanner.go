@@ -21,15 +21,33 @@ type (
 		Err() error
 	}
 
+	// RecordScanner is implemented by the Scanner returned from NewScanner and QueryExecutor#Scanner. It scans rows
+	// with columns that are not known at compile time into records.
+	//
+	//	if rs, ok := scanner.(exec.RecordScanner); ok {
+	//	    record, err := rs.ScanRecord()
+	//	}
+	RecordScanner interface {
+		Scanner
+		ScanRecord() (exp.Record, error)
+	}
+
 	scanner struct {
-		rows      *sql.Rows
-		columnMap util.ColumnMap
-		columns   []string
+		rows        *sql.Rows
+		columnMap   util.ColumnMap
+		columns     []string
+		columnTypes []*sql.ColumnType
+		converter   ColumnConverter
+		mode        ScanMode
 	}
 )
 
 func unableToFindFieldError(col string) error {
-	return errors.New(`unable to find corresponding field to column "%s" returned by query`, col)
+	return errors.NewMissingFieldError(`unable to find corresponding field to column "%s" returned by query`, col)
+}
+
+func unpopulatedFieldError(col string) error {
+	return errors.NewScanMismatchError(`unable to find column "%s" in query results to populate corresponding field`, col)
 }
 
 // NewScanner returns a scanner that can be used for scanning rows into structs.
@@ -51,6 +69,11 @@ func (s *scanner) Err() error {
 
 // ScanStruct will scan the current row into i.
 func (s *scanner) ScanStruct(i interface{}) error {
+	return s.scanStruct(i, nil)
+}
+
+// scans the current row into i, the columns in extras are scanned into their destination instead of a field of i.
+func (s *scanner) scanStruct(i interface{}, extras map[string]interface{}) error {
 	// Setup columnMap and columns, but only once.
 	if s.columnMap == nil || s.columns == nil {
 		cm, err := util.GetColumnMap(i)
@@ -63,14 +86,24 @@ func (s *scanner) ScanStruct(i interface{}) error {
 			return err
 		}
 
+		if err = s.checkPopulatedFields(cm, cols, extras); err != nil {
+			return err
+		}
+
 		s.columnMap = cm
 		s.columns = cols
 	}
 
 	scans := make([]interface{}, 0, len(s.columns))
 	for _, col := range s.columns {
+		if dest, ok := extras[col]; ok {
+			scans = append(scans, dest)
+			continue
+		}
 		data, ok := s.columnMap[col]
 		switch {
+		case !ok && s.mode.resolve() == ScanModeIgnoreUnknownColumns:
+			scans = append(scans, discardScanner{})
 		case !ok:
 			return unableToFindFieldError(col)
 		default:
@@ -84,7 +117,10 @@ func (s *scanner) ScanStruct(i interface{}) error {
 
 	record := exp.Record{}
 	for index, col := range s.columns {
-		record[col] = scans[index]
+		_, isExtra := extras[col]
+		if _, ok := s.columnMap[col]; ok && !isExtra {
+			record[col] = scans[index]
+		}
 	}
 
 	util.AssignStructVals(i, record, s.columnMap)
@@ -92,6 +128,25 @@ func (s *scanner) ScanStruct(i interface{}) error {
 	return s.Err()
 }
 
+// in ScanModeStrict returns an error if a field of the struct does not have a corresponding column
+func (s *scanner) checkPopulatedFields(cm util.ColumnMap, cols []string, extras map[string]interface{}) error {
+	if s.mode.resolve() != ScanModeStrict {
+		return nil
+	}
+	populated := make(map[string]bool, len(cols))
+	for _, col := range cols {
+		if _, ok := extras[col]; !ok {
+			populated[col] = true
+		}
+	}
+	for _, col := range cm.Cols() {
+		if !populated[col] {
+			return unpopulatedFieldError(col)
+		}
+	}
+	return nil
+}
+
 // ScanStructs scans results in slice of structs
 func (s *scanner) ScanStructs(i interface{}) error {
 	val, err := checkScanStructsTarget(i)
@@ -103,6 +158,20 @@ func (s *scanner) ScanStructs(i interface{}) error {
 	})
 }
 
+// scans results into a slice of structs and the countCol column of each row into the returned count
+func (s *scanner) scanStructsAndCount(i interface{}, countCol string) (int64, error) {
+	val, err := checkScanStructsTarget(i)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	extras := map[string]interface{}{countCol: &count}
+	err = s.scanIntoSlice(val, func(i interface{}) error {
+		return s.scanStruct(i, extras)
+	})
+	return count, err
+}
+
 // ScanVal will scan the current row and column into i.
 func (s *scanner) ScanVal(i interface{}) error {
 	if err := s.rows.Scan(i); err != nil {
@@ -123,6 +192,56 @@ func (s *scanner) ScanVals(i interface{}) error {
 	})
 }
 
+// ScanRecord will scan the current row into an exp.Record keyed by the column names. The type of each value is
+// determined by the driver, []byte values of text columns are converted to a string (see ConvertColumnValue).
+func (s *scanner) ScanRecord() (exp.Record, error) {
+	// Setup columnTypes, but only once.
+	if s.columnTypes == nil {
+		cts, err := s.rows.ColumnTypes()
+		if err != nil {
+			return nil, err
+		}
+		s.columnTypes = cts
+	}
+
+	vals := make([]interface{}, len(s.columnTypes))
+	scans := make([]interface{}, len(s.columnTypes))
+	for index := range vals {
+		scans[index] = &vals[index]
+	}
+	if err := s.rows.Scan(scans...); err != nil {
+		return nil, err
+	}
+
+	convert := s.converter
+	if convert == nil {
+		convert = ConvertColumnValue
+	}
+	record := make(exp.Record, len(s.columnTypes))
+	for index, ct := range s.columnTypes {
+		val, err := convert(ct.DatabaseTypeName(), vals[index])
+		if err != nil {
+			return nil, err
+		}
+		record[ct.Name()] = val
+	}
+
+	return record, s.Err()
+}
+
+// scans all rows into records
+func (s *scanner) scanRecords() ([]exp.Record, error) {
+	var records []exp.Record
+	for s.Next() {
+		record, err := s.ScanRecord()
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, s.Err()
+}
+
 // Close closes the Rows, preventing further enumeration. See sql.Rows#Close
 // for more info.
 func (s *scanner) Close() error {
@@ -1,9 +1,12 @@
 package exec
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -67,3 +70,110 @@ func (s *scannerSuite) TestScanVals() {
 	s.Require().NoError(err)
 	s.Require().ElementsMatch([]int{1, 2}, result)
 }
+
+func (s *scannerSuite) TestScanRecord() {
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+
+	mock.ExpectQuery(`SELECT \* FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(
+			sqlmock.NewColumn("id").OfType("BIGINT", int64(0)),
+			sqlmock.NewColumn("name").OfType("VARCHAR", ""),
+			sqlmock.NewColumn("data").OfType("BLOB", []byte{}),
+		).
+			AddRow(int64(1), []byte(testName1), []byte("data1")).
+			AddRow(int64(2), nil, nil),
+		)
+	rows, err := db.Query(`SELECT * FROM "items"`)
+	s.Require().NoError(err)
+
+	sc, ok := NewScanner(rows).(RecordScanner)
+	s.Require().True(ok)
+
+	s.Require().True(sc.Next())
+	record, err := sc.ScanRecord()
+	s.Require().NoError(err)
+	s.Equal(exp.Record{"id": int64(1), "name": testName1, "data": []byte("data1")}, record)
+
+	s.Require().True(sc.Next())
+	record, err = sc.ScanRecord()
+	s.Require().NoError(err)
+	s.Equal(exp.Record{"id": int64(2), "name": nil, "data": nil}, record)
+
+	s.False(sc.Next())
+	s.NoError(sc.Err())
+}
+
+func (s *scannerSuite) TestConvertColumnValue() {
+	for _, typeName := range []string{"CHAR", "VARCHAR", "NVARCHAR", "TEXT", "LONGTEXT", "json", "DECIMAL", "UUID"} {
+		val, err := ConvertColumnValue(typeName, []byte("value"))
+		s.NoError(err)
+		s.Equal("value", val, typeName)
+	}
+	for _, typeName := range []string{"BLOB", "VARBINARY", "BYTEA", ""} {
+		val, err := ConvertColumnValue(typeName, []byte("value"))
+		s.NoError(err)
+		s.Equal([]byte("value"), val, typeName)
+	}
+	val, err := ConvertColumnValue("VARCHAR", nil)
+	s.NoError(err)
+	s.Nil(val)
+	val, err = ConvertColumnValue("INT", int64(1))
+	s.NoError(err)
+	s.Equal(int64(1), val)
+}
+
+func (s *scannerSuite) TestScanStruct_ScanModes() {
+	type StructWithTags struct {
+		Address string `db:"address"`
+		Name    string `db:"name"`
+	}
+	db, mock, err := sqlmock.New()
+	s.Require().NoError(err)
+
+	query := func(cols []string, vals ...driver.Value) *sql.Rows {
+		mock.ExpectQuery(`SELECT \* FROM "items"`).
+			WithArgs().
+			WillReturnRows(sqlmock.NewRows(cols).AddRow(vals...))
+		rows, queryErr := db.Query(`SELECT * FROM "items"`)
+		s.Require().NoError(queryErr)
+		return rows
+	}
+	scan := func(mode ScanMode, rows *sql.Rows) (StructWithTags, error) {
+		sc := &scanner{rows: rows, mode: mode}
+		defer func() { _ = sc.Close() }()
+		s.Require().True(sc.Next())
+		var item StructWithTags
+		return item, sc.ScanStruct(&item)
+	}
+	allCols := []string{"address", "name", "other"}
+
+	_, err = scan(ScanModeDefault, query(allCols, testAddr1, testName1, "other"))
+	s.EqualError(err, `goqu: unable to find corresponding field to column "other" returned by query`)
+	_, err = scan(ScanModeMatchColumns, query(allCols, testAddr1, testName1, "other"))
+	s.EqualError(err, `goqu: unable to find corresponding field to column "other" returned by query`)
+	_, err = scan(ScanModeStrict, query(allCols, testAddr1, testName1, "other"))
+	s.EqualError(err, `goqu: unable to find corresponding field to column "other" returned by query`)
+
+	item, err := scan(ScanModeIgnoreUnknownColumns, query(allCols, testAddr1, testName1, "other"))
+	s.NoError(err)
+	s.Equal(StructWithTags{Address: testAddr1, Name: testName1}, item)
+
+	item, err = scan(ScanModeDefault, query([]string{"name"}, testName1))
+	s.NoError(err)
+	s.Equal(StructWithTags{Name: testName1}, item)
+	_, err = scan(ScanModeStrict, query([]string{"name"}, testName1))
+	s.EqualError(err, `goqu: unable to find column "address" in query results to populate corresponding field`)
+	item, err = scan(ScanModeStrict, query([]string{"address", "name"}, testAddr1, testName1))
+	s.NoError(err)
+	s.Equal(StructWithTags{Address: testAddr1, Name: testName1}, item)
+
+	defer SetDefaultScanMode(ScanModeDefault)
+	SetDefaultScanMode(ScanModeIgnoreUnknownColumns)
+	item, err = scan(ScanModeDefault, query(allCols, testAddr1, testName1, "other"))
+	s.NoError(err)
+	s.Equal(StructWithTags{Address: testAddr1, Name: testName1}, item)
+	_, err = scan(ScanModeMatchColumns, query(allCols, testAddr1, testName1, "other"))
+	s.EqualError(err, `goqu: unable to find corresponding field to column "other" returned by query`)
+}
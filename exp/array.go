@@ -0,0 +1,121 @@
+package exp
+
+import "reflect"
+
+type (
+	arrayExp struct {
+		lhs Expression
+		rhs interface{}
+		op  ArrayOperation
+	}
+	arrayOps struct {
+		lhs Expression
+	}
+	arrayValue struct {
+		elements interface{}
+	}
+)
+
+func NewArrayExpression(op ArrayOperation, lhs Expression, rhs interface{}) ArrayExpression {
+	return arrayExp{op: op, lhs: lhs, rhs: rhs}
+}
+
+// Creates the array operators for an expression (e.g. NewArrayOperators(I("tags")).Overlaps([]string{"a"}) ->
+// "tags" && ARRAY['a'])
+func NewArrayOperators(lhs Expression) ArrayOperators {
+	return arrayOps{lhs: lhs}
+}
+
+// Creates a new ArrayValueExpression for a slice (e.g. NewArrayValueExpression([]int{1, 2}) -> ARRAY[1, 2])
+func NewArrayValueExpression(elements interface{}) ArrayValueExpression {
+	return arrayValue{elements: elements}
+}
+
+// Returns true if the value is a slice that should be generated as an ArrayValueExpression. []byte is not considered
+// an array.
+func IsArrayValue(val interface{}) bool {
+	if _, ok := val.([]byte); ok {
+		return false
+	}
+	return reflect.Indirect(reflect.ValueOf(val)).Kind() == reflect.Slice
+}
+
+func (a arrayExp) Clone() Expression {
+	return NewArrayExpression(a.op, a.lhs.Clone(), a.rhs)
+}
+
+func (a arrayExp) RHS() interface{} {
+	return a.rhs
+}
+
+func (a arrayExp) LHS() Expression {
+	return a.lhs
+}
+
+func (a arrayExp) Op() ArrayOperation {
+	return a.op
+}
+
+func (a arrayExp) Expression() Expression                      { return a }
+func (a arrayExp) As(val interface{}) AliasedExpression        { return NewAliasExpression(a, val) }
+func (a arrayExp) Eq(val interface{}) BooleanExpression        { return eq(a, val) }
+func (a arrayExp) Neq(val interface{}) BooleanExpression       { return neq(a, val) }
+func (a arrayExp) Gt(val interface{}) BooleanExpression        { return gt(a, val) }
+func (a arrayExp) Gte(val interface{}) BooleanExpression       { return gte(a, val) }
+func (a arrayExp) Lt(val interface{}) BooleanExpression        { return lt(a, val) }
+func (a arrayExp) Lte(val interface{}) BooleanExpression       { return lte(a, val) }
+func (a arrayExp) Asc() OrderedExpression                      { return asc(a) }
+func (a arrayExp) Desc() OrderedExpression                     { return desc(a) }
+func (a arrayExp) In(i ...interface{}) BooleanExpression       { return in(a, i...) }
+func (a arrayExp) NotIn(i ...interface{}) BooleanExpression    { return notIn(a, i...) }
+func (a arrayExp) Is(i interface{}) BooleanExpression          { return is(a, i) }
+func (a arrayExp) IsNot(i interface{}) BooleanExpression       { return isNot(a, i) }
+func (a arrayExp) IsNull() BooleanExpression                   { return is(a, nil) }
+func (a arrayExp) IsNotNull() BooleanExpression                { return isNot(a, nil) }
+func (a arrayExp) IsTrue() BooleanExpression                   { return is(a, true) }
+func (a arrayExp) IsNotTrue() BooleanExpression                { return isNot(a, true) }
+func (a arrayExp) IsFalse() BooleanExpression                  { return is(a, false) }
+func (a arrayExp) IsNotFalse() BooleanExpression               { return isNot(a, false) }
+func (a arrayExp) Distinct() SQLFunctionExpression             { return NewSQLFunctionExpression("DISTINCT", a) }
+func (a arrayExp) Cast(t string) CastExpression                { return NewCastExpression(a, t) }
+func (a arrayExp) Contains(val interface{}) ArrayExpression    { return arrayContains(a, val) }
+func (a arrayExp) ContainedBy(val interface{}) ArrayExpression { return arrayContainedBy(a, val) }
+func (a arrayExp) Overlaps(val interface{}) ArrayExpression    { return arrayOverlaps(a, val) }
+func (a arrayExp) Concat(val interface{}) ArrayExpression      { return arrayConcat(a, val) }
+
+func (ao arrayOps) Contains(val interface{}) ArrayExpression    { return arrayContains(ao.lhs, val) }
+func (ao arrayOps) ContainedBy(val interface{}) ArrayExpression { return arrayContainedBy(ao.lhs, val) }
+func (ao arrayOps) Overlaps(val interface{}) ArrayExpression    { return arrayOverlaps(ao.lhs, val) }
+func (ao arrayOps) Concat(val interface{}) ArrayExpression      { return arrayConcat(ao.lhs, val) }
+
+func (av arrayValue) Clone() Expression {
+	return NewArrayValueExpression(av.elements)
+}
+
+func (av arrayValue) Expression() Expression {
+	return av
+}
+
+func (av arrayValue) Elements() interface{} {
+	return av.elements
+}
+
+// used internally to create an Array Contains ArrayExpression
+func arrayContains(lhs Expression, val interface{}) ArrayExpression {
+	return NewArrayExpression(ArrayContainsOp, lhs, val)
+}
+
+// used internally to create an Array Contained By ArrayExpression
+func arrayContainedBy(lhs Expression, val interface{}) ArrayExpression {
+	return NewArrayExpression(ArrayContainedByOp, lhs, val)
+}
+
+// used internally to create an Array Overlaps ArrayExpression
+func arrayOverlaps(lhs Expression, val interface{}) ArrayExpression {
+	return NewArrayExpression(ArrayOverlapsOp, lhs, val)
+}
+
+// used internally to create an Array Concat ArrayExpression
+func arrayConcat(lhs Expression, val interface{}) ArrayExpression {
+	return NewArrayExpression(ArrayConcatOp, lhs, val)
+}
@@ -0,0 +1,134 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type arrayExpressionSuite struct {
+	suite.Suite
+}
+
+func TestArrayExpressionSuite(t *testing.T) {
+	suite.Run(t, &arrayExpressionSuite{})
+}
+
+func (aes *arrayExpressionSuite) TestClone() {
+	ae := exp.NewArrayExpression(exp.ArrayContainsOp, exp.NewIdentifierExpression("", "", "tags"), []string{"a"})
+	aes.Equal(ae, ae.Clone())
+
+	av := exp.NewArrayValueExpression([]string{"a"})
+	aes.Equal(av, av.Clone())
+}
+
+func (aes *arrayExpressionSuite) TestExpression() {
+	ae := exp.NewArrayExpression(exp.ArrayContainsOp, exp.NewIdentifierExpression("", "", "tags"), []string{"a"})
+	aes.Equal(ae, ae.Expression())
+
+	av := exp.NewArrayValueExpression([]string{"a"})
+	aes.Equal(av, av.Expression())
+}
+
+func (aes *arrayExpressionSuite) TestAs() {
+	ae := exp.NewArrayExpression(exp.ArrayConcatOp, exp.NewIdentifierExpression("", "", "tags"), []string{"a"})
+	aes.Equal(exp.NewAliasExpression(ae, "a"), ae.As("a"))
+}
+
+func (aes *arrayExpressionSuite) TestAsc() {
+	ae := exp.NewArrayExpression(exp.ArrayConcatOp, exp.NewIdentifierExpression("", "", "tags"), []string{"a"})
+	aes.Equal(exp.NewOrderedExpression(ae, exp.AscDir, exp.NoNullsSortType), ae.Asc())
+}
+
+func (aes *arrayExpressionSuite) TestDesc() {
+	ae := exp.NewArrayExpression(exp.ArrayConcatOp, exp.NewIdentifierExpression("", "", "tags"), []string{"a"})
+	aes.Equal(exp.NewOrderedExpression(ae, exp.DescSortDir, exp.NoNullsSortType), ae.Desc())
+}
+
+func (aes *arrayExpressionSuite) TestCast() {
+	ae := exp.NewArrayExpression(exp.ArrayConcatOp, exp.NewIdentifierExpression("", "", "tags"), []string{"a"})
+	aes.Equal(exp.NewCastExpression(ae, "TEXT[]"), ae.Cast("TEXT[]"))
+}
+
+func (aes *arrayExpressionSuite) TestElements() {
+	elements := []int{1, 2}
+	aes.Equal(elements, exp.NewArrayValueExpression(elements).Elements())
+}
+
+func (aes *arrayExpressionSuite) TestIsArrayValue() {
+	ints := []int{1}
+	aes.True(exp.IsArrayValue([]string{"a"}))
+	aes.True(exp.IsArrayValue(&ints))
+	aes.True(exp.IsArrayValue([]interface{}{}))
+	aes.False(exp.IsArrayValue([]byte("a")))
+	aes.False(exp.IsArrayValue("a"))
+	aes.False(exp.IsArrayValue(1))
+	aes.False(exp.IsArrayValue(nil))
+}
+
+func (aes *arrayExpressionSuite) TestOperators() {
+	ident := exp.NewIdentifierExpression("", "", "tags")
+	ae := exp.NewArrayExpression(exp.ArrayConcatOp, ident, []string{"a"})
+	val := []string{"b"}
+	testCases := []struct {
+		Ex       exp.ArrayExpression
+		Expected exp.ArrayExpression
+	}{
+		{Ex: ident.Array().Contains(val), Expected: exp.NewArrayExpression(exp.ArrayContainsOp, ident, val)},
+		{Ex: ident.Array().ContainedBy(val), Expected: exp.NewArrayExpression(exp.ArrayContainedByOp, ident, val)},
+		{Ex: ident.Array().Overlaps(val), Expected: exp.NewArrayExpression(exp.ArrayOverlapsOp, ident, val)},
+		{Ex: ident.Array().Concat(val), Expected: exp.NewArrayExpression(exp.ArrayConcatOp, ident, val)},
+
+		{Ex: ae.Contains(val), Expected: exp.NewArrayExpression(exp.ArrayContainsOp, ae, val)},
+		{Ex: ae.ContainedBy(val), Expected: exp.NewArrayExpression(exp.ArrayContainedByOp, ae, val)},
+		{Ex: ae.Overlaps(val), Expected: exp.NewArrayExpression(exp.ArrayOverlapsOp, ae, val)},
+		{Ex: ae.Concat(val), Expected: exp.NewArrayExpression(exp.ArrayConcatOp, ae, val)},
+	}
+
+	for _, tc := range testCases {
+		aes.Equal(tc.Expected, tc.Ex)
+		aes.Equal(tc.Expected.Op(), tc.Ex.Op())
+		aes.Equal(tc.Expected.LHS(), tc.Ex.LHS())
+		aes.Equal(tc.Expected.RHS(), tc.Ex.RHS())
+	}
+}
+
+func (aes *arrayExpressionSuite) TestAllOthers() {
+	ae := exp.NewArrayExpression(exp.ArrayConcatOp, exp.NewIdentifierExpression("", "", "tags"), []string{"a"})
+	inVals := []interface{}{1, 2}
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: ae.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, ae, 1)},
+		{Ex: ae.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, ae, 1)},
+		{Ex: ae.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, ae, 1)},
+		{Ex: ae.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, ae, 1)},
+		{Ex: ae.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, ae, 1)},
+		{Ex: ae.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, ae, 1)},
+		{Ex: ae.In(inVals), Expected: exp.NewBooleanExpression(exp.InOp, ae, inVals)},
+		{Ex: ae.NotIn(inVals), Expected: exp.NewBooleanExpression(exp.NotInOp, ae, inVals)},
+		{Ex: ae.Is(true), Expected: exp.NewBooleanExpression(exp.IsOp, ae, true)},
+		{Ex: ae.IsNot(true), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, true)},
+		{Ex: ae.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, ae, nil)},
+		{Ex: ae.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, nil)},
+		{Ex: ae.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, ae, true)},
+		{Ex: ae.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, true)},
+		{Ex: ae.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, ae, false)},
+		{Ex: ae.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, ae, false)},
+		{Ex: ae.Distinct(), Expected: exp.NewSQLFunctionExpression("DISTINCT", ae)},
+	}
+
+	for _, tc := range testCases {
+		aes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+func (aes *arrayExpressionSuite) TestArrayOperation_String() {
+	aes.Equal("contains", exp.ArrayContainsOp.String())
+	aes.Equal("contained by", exp.ArrayContainedByOp.String())
+	aes.Equal("overlaps", exp.ArrayOverlapsOp.String())
+	aes.Equal("concat", exp.ArrayConcatOp.String())
+	aes.Equal("100", exp.ArrayOperation(100).String())
+}
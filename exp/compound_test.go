@@ -0,0 +1,29 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type compoundExpressionSuite struct {
+	suite.Suite
+}
+
+func TestCompoundExpressionSuite(t *testing.T) {
+	suite.Run(t, new(compoundExpressionSuite))
+}
+
+func (ces *compoundExpressionSuite) TestCompoundTypeValues() {
+	ces.Equal([]exp.CompoundType{0, 1, 2, 3, 4, 5}, []exp.CompoundType{
+		exp.UnionCompoundType,
+		exp.UnionAllCompoundType,
+		exp.IntersectCompoundType,
+		exp.IntersectAllCompoundType,
+		exp.ExceptCompoundType,
+		exp.ExceptAllCompoundType,
+	})
+	// adding compound types must not change the values of the constants declared after them
+	ces.Equal(exp.ConflictAction(4), exp.DoNothingConflictAction)
+}
@@ -5,8 +5,15 @@ type (
 	// ConflictUpdate is the struct that represents the UPDATE fragment of an
 	// INSERT ... ON CONFLICT/ON DUPLICATE KEY DO UPDATE statement
 	conflictUpdate struct {
-		target      string
-		update      interface{}
+		target         string
+		conflictTarget ConflictTarget
+		update         interface{}
+		fromInsert     bool
+		whereClause    ExpressionList
+	}
+	conflictTarget struct {
+		columns     ColumnListExpression
+		constraint  string
 		whereClause ExpressionList
 	}
 )
@@ -41,16 +48,42 @@ func NewDoUpdateConflictExpression(target string, update interface{}) ConflictUp
 	return &conflictUpdate{target: target, update: update}
 }
 
+// Creates a ConflictUpdate struct that updates every inserted column, other than the target columns, with the value
+// proposed for insertion. The update is derived from the columns of the INSERT when the SQL is generated.
+//
+//	InsertConflict(NewDoUpdateFromInsertConflictExpression(NewConflictColumnsTarget("a")),...) ->
+//		INSERT INTO ... ("a", "b") VALUES ... ON CONFLICT ("a") DO UPDATE SET "b"=EXCLUDED."b"
+func NewDoUpdateFromInsertConflictExpression(target ConflictTarget) ConflictUpdateExpression {
+	return &conflictUpdate{conflictTarget: target, fromInsert: true}
+}
+
+// Creates a ConflictUpdate struct with a structured conflict target to be passed to InsertConflict
+//
+//	InsertConflict(DoUpdateOn(ConflictColumns("a", "b"), update),...) ->
+//		INSERT INTO ... ON CONFLICT ("a", "b") DO UPDATE SET a=b
+//	InsertConflict(DoUpdateOn(ConflictConstraint("a_key"), update),...) ->
+//		INSERT INTO ... ON CONFLICT ON CONSTRAINT "a_key" DO UPDATE SET a=b
+func NewDoUpdateConflictTargetExpression(target ConflictTarget, update interface{}) ConflictUpdateExpression {
+	return &conflictUpdate{conflictTarget: target, update: update}
+}
+
 func (c conflictUpdate) Expression() Expression {
 	return c
 }
 
 func (c conflictUpdate) Clone() Expression {
-	return &conflictUpdate{
-		target:      c.target,
-		update:      c.update,
-		whereClause: c.whereClause.Clone().(ExpressionList),
+	ret := &conflictUpdate{
+		target:     c.target,
+		update:     c.update,
+		fromInsert: c.fromInsert,
+	}
+	if c.whereClause != nil {
+		ret.whereClause = c.whereClause.Clone().(ExpressionList)
 	}
+	if c.conflictTarget != nil {
+		ret.conflictTarget = c.conflictTarget.Clone().(ConflictTarget)
+	}
+	return ret
 }
 
 func (c conflictUpdate) Action() ConflictAction {
@@ -63,6 +96,16 @@ func (c conflictUpdate) TargetColumn() string {
 	return c.target
 }
 
+// Returns the structured conflict target, nil if the target was provided as a string.
+func (c conflictUpdate) Target() ConflictTarget {
+	return c.conflictTarget
+}
+
+// Returns true if the update should be derived from the inserted columns.
+func (c conflictUpdate) UpdateFromInsert() bool {
+	return c.fromInsert
+}
+
 // Returns the Updates which represent the ON CONFLICT DO UPDATE portion of an insert statement. If nil,
 // there are no updates.
 func (c conflictUpdate) Update() interface{} {
@@ -87,3 +130,64 @@ func (c *conflictUpdate) Where(expressions ...Expression) ConflictUpdateExpressi
 func (c *conflictUpdate) WhereClause() ExpressionList {
 	return c.whereClause
 }
+
+// Creates a conflict target for a list of columns
+//
+//	NewConflictColumnsTarget("a", "b") -> ON CONFLICT ("a", "b")
+func NewConflictColumnsTarget(cols ...interface{}) ConflictTarget {
+	return conflictTarget{columns: NewColumnListExpression(cols...)}
+}
+
+// Creates a conflict target for a named constraint
+//
+//	NewConflictConstraintTarget("a_key") -> ON CONFLICT ON CONSTRAINT "a_key"
+func NewConflictConstraintTarget(name string) ConflictTarget {
+	return conflictTarget{constraint: name}
+}
+
+func (ct conflictTarget) Expression() Expression {
+	return ct
+}
+
+func (ct conflictTarget) Clone() Expression {
+	ret := ct
+	if ct.columns != nil {
+		ret.columns = ct.columns.Clone().(ColumnListExpression)
+	}
+	if ct.whereClause != nil {
+		ret.whereClause = ct.whereClause.Clone().(ExpressionList)
+	}
+	return ret
+}
+
+func (ct conflictTarget) IsConstraint() bool {
+	return ct.constraint != ""
+}
+
+func (ct conflictTarget) Constraint() string {
+	return ct.constraint
+}
+
+func (ct conflictTarget) Columns() ColumnListExpression {
+	return ct.columns
+}
+
+// Append to the partial index predicate of the conflict target
+//
+//	NewConflictColumnsTarget("a").Where(Ex{"b": nil}) -> ON CONFLICT ("a") WHERE ("b" IS NULL)
+func (ct conflictTarget) Where(expressions ...Expression) ConflictTarget {
+	if len(expressions) == 0 {
+		return ct
+	}
+	ret := ct
+	if ret.whereClause == nil {
+		ret.whereClause = NewExpressionList(AndType, expressions...)
+	} else {
+		ret.whereClause = ret.whereClause.Append(expressions...)
+	}
+	return ret
+}
+
+func (ct conflictTarget) WhereClause() ExpressionList {
+	return ct.whereClause
+}
@@ -0,0 +1,89 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type conflictExpressionSuite struct {
+	suite.Suite
+}
+
+func TestConflictExpressionSuite(t *testing.T) {
+	suite.Run(t, new(conflictExpressionSuite))
+}
+
+func (ces *conflictExpressionSuite) TestDoUpdateConflictExpression() {
+	cu := exp.NewDoUpdateConflictExpression("a,b", exp.Record{"a": 1})
+	ces.Equal(exp.DoUpdateConflictAction, cu.Action())
+	ces.Equal("a,b", cu.TargetColumn())
+	ces.Nil(cu.Target())
+	ces.Equal(exp.Record{"a": 1}, cu.Update())
+}
+
+func (ces *conflictExpressionSuite) TestDoUpdateConflictTargetExpression() {
+	ct := exp.NewConflictColumnsTarget("a", "b")
+	cu := exp.NewDoUpdateConflictTargetExpression(ct, exp.Record{"a": 1})
+	ces.Equal(exp.DoUpdateConflictAction, cu.Action())
+	ces.Empty(cu.TargetColumn())
+	ces.Equal(ct, cu.Target())
+	ces.Equal(exp.Record{"a": 1}, cu.Update())
+
+	cu = cu.Where(exp.Ex{"a": 1})
+	ces.Equal(cu, cu.Clone())
+}
+
+func (ces *conflictExpressionSuite) TestDoUpdateFromInsertConflictExpression() {
+	ct := exp.NewConflictColumnsTarget("a")
+	cu := exp.NewDoUpdateFromInsertConflictExpression(ct)
+	ces.Equal(exp.DoUpdateConflictAction, cu.Action())
+	ces.True(cu.UpdateFromInsert())
+	ces.Equal(ct, cu.Target())
+	ces.Nil(cu.Update())
+	ces.Equal(cu, cu.Clone())
+
+	ces.False(exp.NewDoUpdateConflictExpression("a", exp.Record{"a": 1}).UpdateFromInsert())
+}
+
+func (ces *conflictExpressionSuite) TestExcludedExpression() {
+	ex := exp.NewExcludedExpression("a")
+	ces.Equal(exp.NewIdentifierExpression("", "", "a"), ex.Column())
+	ces.Equal(ex, ex.Expression())
+	ces.Equal(ex, ex.Clone())
+}
+
+func (ces *conflictExpressionSuite) TestConflictColumnsTarget() {
+	ct := exp.NewConflictColumnsTarget("a", "b")
+	ces.False(ct.IsConstraint())
+	ces.Empty(ct.Constraint())
+	ces.Equal(exp.NewColumnListExpression("a", "b"), ct.Columns())
+	ces.Nil(ct.WhereClause())
+	ces.Equal(ct, ct.Expression())
+	ces.Equal(ct, ct.Clone())
+}
+
+func (ces *conflictExpressionSuite) TestConflictConstraintTarget() {
+	ct := exp.NewConflictConstraintTarget("a_key")
+	ces.True(ct.IsConstraint())
+	ces.Equal("a_key", ct.Constraint())
+	ces.Nil(ct.Columns())
+	ces.Nil(ct.WhereClause())
+	ces.Equal(ct, ct.Clone())
+}
+
+func (ces *conflictExpressionSuite) TestConflictTarget_Where() {
+	w := exp.Ex{"a": nil}
+	w2 := exp.Ex{"b": 1}
+
+	ct := exp.NewConflictColumnsTarget("a")
+	ct2 := ct.Where(w)
+	ct3 := ct2.Where(w2)
+
+	ces.Equal(ct, ct.Where())
+	ces.Nil(ct.WhereClause())
+	ces.Equal(exp.NewExpressionList(exp.AndType, w), ct2.WhereClause())
+	ces.Equal(exp.NewExpressionList(exp.AndType, w).Append(w2), ct3.WhereClause())
+	ces.Equal(ct3, ct3.Clone())
+}
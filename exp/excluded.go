@@ -0,0 +1,26 @@
+package exp
+
+type excluded struct {
+	col IdentifierExpression
+}
+
+// Creates a reference to a column of the row proposed for insertion in an ON CONFLICT DO UPDATE clause. The SQL is
+// specific to the dialect
+//
+//	NewExcludedExpression("a") -> EXCLUDED."a" (postgres, sqlite3)
+//	NewExcludedExpression("a") -> VALUES(`a`) (mysql)
+func NewExcludedExpression(col string) ExcludedExpression {
+	return excluded{col: NewIdentifierExpression("", "", col)}
+}
+
+func (e excluded) Expression() Expression {
+	return e
+}
+
+func (e excluded) Clone() Expression {
+	return excluded{col: e.col.Clone().(IdentifierExpression)}
+}
+
+func (e excluded) Column() IdentifierExpression {
+	return e.col
+}
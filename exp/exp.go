@@ -159,6 +159,59 @@ type (
 		// I("col").BitRighttShift(1) // ("col" >> 1)
 		BitwiseRightShift(interface{}) BitwiseExpression
 	}
+
+	// Interface that an expression should implement if it can be used with JSON operators.
+	JSONable interface {
+		// Returns the JSON operators for the expression
+		//   I("doc").JSON().Get("a") // "doc"->'a'
+		JSON() JSONOperators
+	}
+
+	JSONOperators interface {
+		// Creates a JSON Expression that extracts an object field or array element as JSON
+		//   I("doc").JSON().Get("a") // "doc"->'a', JSON_EXTRACT(`doc`, '$.a')
+		Get(key interface{}) JSONExpression
+		// Creates a JSON Expression that extracts an object field or array element as text
+		//   I("doc").JSON().GetText("a") // "doc"->>'a', JSON_UNQUOTE(JSON_EXTRACT(`doc`, '$.a'))
+		GetText(key interface{}) JSONExpression
+		// Creates a JSON Expression that extracts the JSON at the specified path
+		//   I("doc").JSON().Path("a", 0) // "doc"#>'{a,0}', JSON_EXTRACT(`doc`, '$.a[0]')
+		Path(path ...interface{}) JSONExpression
+		// Creates a JSON Expression that extracts the JSON at the specified path as text
+		//   I("doc").JSON().PathText("a", 0) // "doc"#>>'{a,0}', JSON_UNQUOTE(JSON_EXTRACT(`doc`, '$.a[0]'))
+		PathText(path ...interface{}) JSONExpression
+		// Creates a JSON Expression that checks if the JSON contains the value. Values that are not a string,
+		// []byte or Expression are encoded as JSON.
+		//   I("doc").JSON().Contains(map[string]int{"a": 1}) // ("doc" @> '{"a":1}'), JSON_CONTAINS(`doc`, '{"a":1}')
+		Contains(val interface{}) JSONExpression
+		// Creates a JSON Expression that checks if the JSON has the top level key
+		//   I("doc").JSON().HasKey("a") // ("doc" ? 'a'), (JSON_EXTRACT(`doc`, '$.a') IS NOT NULL)
+		HasKey(key string) JSONExpression
+	}
+
+	// Interface that an expression should implement if it can be used with array operators.
+	Arrayable interface {
+		// Returns the array operators for the expression
+		//   I("tags").Array().Contains([]string{"a"}) // ("tags" @> ARRAY['a'])
+		Array() ArrayOperators
+	}
+
+	// Array operators. Slices used as the right hand side are generated as an array
+	// (e.g. ARRAY['a', 'b']) or as a single array parameter when the statement is prepared.
+	ArrayOperators interface {
+		// Creates an Array Expression that checks if the array contains all elements of another array
+		//   I("tags").Array().Contains([]string{"a"}) // ("tags" @> ARRAY['a'])
+		Contains(val interface{}) ArrayExpression
+		// Creates an Array Expression that checks if all elements of the array are in another array
+		//   I("tags").Array().ContainedBy([]string{"a", "b"}) // ("tags" <@ ARRAY['a', 'b'])
+		ContainedBy(val interface{}) ArrayExpression
+		// Creates an Array Expression that checks if the array has any elements in common with another array
+		//   I("tags").Array().Overlaps([]string{"a", "b"}) // ("tags" && ARRAY['a', 'b'])
+		Overlaps(val interface{}) ArrayExpression
+		// Creates an Array Expression that concatenates the array with another array or element
+		//   I("tags").Array().Concat([]string{"a"}) // ("tags" || ARRAY['a'])
+		Concat(val interface{}) ArrayExpression
+	}
 )
 
 type (
@@ -237,6 +290,63 @@ type (
 		RHS() interface{}
 	}
 
+	JSONOperation  int
+	JSONExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Inable
+		Isable
+		Likeable
+		Rangeable
+		Orderable
+		Distinctable
+		Castable
+		JSONOperators
+		// Returns the operator for the expression
+		Op() JSONOperation
+		// The left hand side of the expression (e.g. I("doc")
+		LHS() Expression
+		// The right hand side of the expression, a key, path ([]interface{}) or value depending on the operator
+		RHS() interface{}
+	}
+
+	ArrayOperation  int
+	ArrayExpression interface {
+		Expression
+		Aliaseable
+		Comparable
+		Inable
+		Isable
+		Orderable
+		Distinctable
+		Castable
+		ArrayOperators
+		// Returns the operator for the expression
+		Op() ArrayOperation
+		// The left hand side of the expression (e.g. I("tags")
+		LHS() Expression
+		// The right hand side of the expression could be a slice, element or expression
+		RHS() interface{}
+	}
+
+	// An Expression that represents a slice that should be generated as a single array
+	//   NewArrayValueExpression([]int{1, 2}) -> ARRAY[1, 2], or $1 when prepared with a dialect that supports array
+	//   parameters
+	ArrayValueExpression interface {
+		Expression
+		// The slice of elements in the array
+		Elements() interface{}
+	}
+
+	// An Expression that represents a value that should be redacted when the statement is logged or traced
+	//   NewSensitiveExpression("secret") -> 'secret' ([REDACTED] when logged)
+	SensitiveExpression interface {
+		Expression
+		// The value to use in the statement
+		Value() interface{}
+	}
+
 	// An Expression that represents another Expression casted to a SQL type
 	CastExpression interface {
 		Expression
@@ -275,9 +385,31 @@ type (
 		Expression
 		Action() ConflictAction
 	}
+	// The target of an ON CONFLICT clause. Either a list of columns with an optional partial index predicate or a
+	// named constraint.
+	ConflictTarget interface {
+		Expression
+		// Returns true if the target is a named constraint (e.g. ON CONSTRAINT "name")
+		IsConstraint() bool
+		// Returns the name of the constraint, empty if the target is a list of columns
+		Constraint() string
+		// Returns the columns of the target, nil if the target is a named constraint
+		Columns() ColumnListExpression
+		Where(expressions ...Expression) ConflictTarget
+		WhereClause() ExpressionList
+	}
+	// A reference to a column of the row proposed for insertion in an ON CONFLICT DO UPDATE clause
+	// (e.g. EXCLUDED."a" or VALUES(`a`))
+	ExcludedExpression interface {
+		Expression
+		Column() IdentifierExpression
+	}
 	ConflictUpdateExpression interface {
 		ConflictExpression
 		TargetColumn() string
+		Target() ConflictTarget
+		// Returns true if the update should be derived from the inserted columns (see InsertDataset#Upsert)
+		UpdateFromInsert() bool
 		Where(expressions ...Expression) ConflictUpdateExpression
 		WhereClause() ExpressionList
 		Update() interface{}
@@ -290,6 +422,18 @@ type (
 		// Returns the Expression being extracted
 		SubQuery() Expression
 	}
+	GroupingSetType int
+	// A grouping set used in a GROUP BY clause
+	//    Rollup("a", "b") //ROLLUP("a", "b")
+	//    Cube("a", "b") //CUBE("a", "b")
+	//    GroupingSets([]string{"a", "b"}, "a", []string{}) //GROUPING SETS(("a", "b"), "a", ())
+	GroupingSetExpression interface {
+		Expression
+		// Returns the type (e.g. ROLLUP, CUBE, GROUPING SETS)
+		Type() GroupingSetType
+		// Returns the elements of the grouping set, each element is a column or a group of columns
+		Elements() []ColumnListExpression
+	}
 	ExpressionListType int
 	// A list of expressions that should be joined together
 	//    And(I("a").Eq(10), I("b").Eq(11)) //(("a" = 10) AND ("b" = 11))
@@ -319,6 +463,8 @@ type (
 		Distinctable
 		Castable
 		Bitwiseable
+		JSONable
+		Arrayable
 		// returns true if this identifier has more more than on part (Schema, Table or Col)
 		//	"schema" -> true //cant qualify anymore
 		//	"schema.table" -> true
@@ -357,6 +503,28 @@ type (
 		SetVals([][]interface{}) InsertExpression
 	}
 
+	MergeWhenType   int
+	MergeActionType int
+	// An action taken by a WHEN [NOT] MATCHED clause of a MERGE statement
+	//    MergeUpdate(Record{"a": "b"}) //UPDATE SET "a"='b'
+	//    MergeDelete().Where(I("a").Gt(1)) //AND ("a" > 1) THEN DELETE
+	MergeAction interface {
+		Expression
+		// Returns the type of action (e.g. UPDATE, DELETE, INSERT, DO NOTHING)
+		Type() MergeActionType
+		// The values used by UPDATE and INSERT actions (e.g. Record, map or struct)
+		Values() interface{}
+		// Adds additional conditions to the WHEN clause (e.g. WHEN MATCHED AND "a" > 1 THEN DELETE)
+		Where(expressions ...Expression) MergeAction
+		WhereClause() ExpressionList
+	}
+	// A WHEN MATCHED or WHEN NOT MATCHED clause of a MERGE statement
+	MergeWhenExpression interface {
+		Expression
+		Type() MergeWhenType
+		Action() MergeAction
+	}
+
 	JoinType       int
 	JoinExpression interface {
 		Expression
@@ -447,6 +615,21 @@ type (
 		Name() string
 		// Arguments to be passed to the function
 		Args() []interface{}
+		// Adds a FILTER (WHERE ...) clause to the function, multiple conditions are ANDed together
+		//	COUNT(Star()).Filter(C("a").Eq(1)) -> COUNT(*) FILTER (WHERE "a" = 1)
+		Filter(conditions ...Expression) SQLFunctionExpression
+		// The FILTER conditions of the function
+		FilterClause() ExpressionList
+		// Returns true if the function has a FILTER clause
+		HasFilter() bool
+		// Sets the WITHIN GROUP (ORDER BY ...) clause of the function
+		//	Func("percentile_cont", 0.5).WithinGroup(C("a").Asc())
+		//	    -> percentile_cont(0.5) WITHIN GROUP (ORDER BY "a" ASC)
+		WithinGroup(order ...OrderedExpression) SQLFunctionExpression
+		// The WITHIN GROUP order of the function
+		WithinGroupOrder() ColumnListExpression
+		// Returns true if the function has a WITHIN GROUP clause
+		HasWithinGroup() bool
 	}
 
 	UpdateExpression interface {
@@ -484,10 +667,20 @@ type (
 		HasPartitionBy() bool
 		OrderCols() ColumnListExpression
 		HasOrder() bool
+		Frame() WindowFrame
+		HasFrame() bool
 
 		Inherit(parent string) WindowExpression
 		PartitionBy(cols ...interface{}) WindowExpression
 		OrderBy(cols ...interface{}) WindowExpression
+		// Sets a ROWS frame, end may be nil (e.g. ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)
+		Rows(start, end WindowFrameBound) WindowExpression
+		// Sets a RANGE frame, end may be nil (e.g. RANGE BETWEEN 1 PRECEDING AND 1 FOLLOWING)
+		Range(start, end WindowFrameBound) WindowExpression
+		// Sets a GROUPS frame, end may be nil (e.g. GROUPS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)
+		Groups(start, end WindowFrameBound) WindowExpression
+		// Sets the EXCLUDE option of the frame (e.g. EXCLUDE CURRENT ROW)
+		Exclude(exclusion WindowFrameExclusion) WindowExpression
 	}
 	CaseElse interface {
 		Result() interface{}
@@ -598,6 +791,46 @@ const (
 	BitwiseXorOp
 	BitwiseLeftShiftOp
 	BitwiseRightShiftOp
+
+	// ->, JSON_EXTRACT
+	JSONGetOp JSONOperation = iota
+	// ->>, JSON_UNQUOTE(JSON_EXTRACT)
+	JSONGetTextOp
+	// #>, JSON_EXTRACT
+	JSONPathOp
+	// #>>, JSON_UNQUOTE(JSON_EXTRACT)
+	JSONPathTextOp
+	// @>, JSON_CONTAINS
+	JSONContainsOp
+	// ?, JSON_EXTRACT IS NOT NULL
+	JSONHasKeyOp
+
+	// @>
+	ArrayContainsOp ArrayOperation = iota
+	// <@
+	ArrayContainedByOp
+	// &&
+	ArrayOverlapsOp
+	// ||
+	ArrayConcatOp
+)
+
+// Declared in their own block so adding them does not change the values of the constants above.
+const (
+	ExceptCompoundType CompoundType = iota + IntersectAllCompoundType + 1
+	ExceptAllCompoundType
+)
+
+const (
+	WhenMatchedMergeType MergeWhenType = iota
+	WhenNotMatchedMergeType
+)
+
+const (
+	UpdateMergeAction MergeActionType = iota
+	DeleteMergeAction
+	InsertMergeAction
+	DoNothingMergeAction
 )
 
 var (
@@ -693,6 +926,38 @@ func (bi BitwiseOperation) String() string {
 	return fmt.Sprintf("%d", bi)
 }
 
+func (jo JSONOperation) String() string {
+	switch jo {
+	case JSONGetOp:
+		return "get"
+	case JSONGetTextOp:
+		return "get text"
+	case JSONPathOp:
+		return "path"
+	case JSONPathTextOp:
+		return "path text"
+	case JSONContainsOp:
+		return "contains"
+	case JSONHasKeyOp:
+		return "has key"
+	}
+	return fmt.Sprintf("%d", jo)
+}
+
+func (ao ArrayOperation) String() string {
+	switch ao {
+	case ArrayContainsOp:
+		return "contains"
+	case ArrayContainedByOp:
+		return "contained by"
+	case ArrayOverlapsOp:
+		return "overlaps"
+	case ArrayConcatOp:
+		return "concat"
+	}
+	return fmt.Sprintf("%d", ao)
+}
+
 func (ro RangeOperation) String() string {
 	switch ro {
 	case BetweenOp:
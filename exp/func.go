@@ -2,8 +2,10 @@ package exp
 
 type (
 	sqlFunctionExpression struct {
-		name string
-		args []interface{}
+		name        string
+		args        []interface{}
+		filter      ExpressionList
+		withinGroup ColumnListExpression
 	}
 )
 
@@ -13,7 +15,7 @@ func NewSQLFunctionExpression(name string, args ...interface{}) SQLFunctionExpre
 }
 
 func (sfe sqlFunctionExpression) Clone() Expression {
-	return sqlFunctionExpression{name: sfe.name, args: sfe.args}
+	return sqlFunctionExpression{name: sfe.name, args: sfe.args, filter: sfe.filter, withinGroup: sfe.withinGroup}
 }
 
 func (sfe sqlFunctionExpression) Expression() Expression { return sfe }
@@ -22,6 +24,39 @@ func (sfe sqlFunctionExpression) Args() []interface{} { return sfe.args }
 
 func (sfe sqlFunctionExpression) Name() string { return sfe.name }
 
+// Adds a FILTER (WHERE ...) clause to the function. Calling Filter multiple times will AND the conditions together
+func (sfe sqlFunctionExpression) Filter(conditions ...Expression) SQLFunctionExpression {
+	if len(conditions) == 0 {
+		return sfe
+	}
+	ret := sfe
+	if ret.filter == nil {
+		ret.filter = NewExpressionList(AndType, conditions...)
+	} else {
+		ret.filter = ret.filter.Append(conditions...)
+	}
+	return ret
+}
+
+func (sfe sqlFunctionExpression) FilterClause() ExpressionList { return sfe.filter }
+
+func (sfe sqlFunctionExpression) HasFilter() bool {
+	return sfe.filter != nil && !sfe.filter.IsEmpty()
+}
+
+// Sets the WITHIN GROUP (ORDER BY ...) clause of the function
+func (sfe sqlFunctionExpression) WithinGroup(order ...OrderedExpression) SQLFunctionExpression {
+	ret := sfe
+	ret.withinGroup = NewOrderedColumnList(order...)
+	return ret
+}
+
+func (sfe sqlFunctionExpression) WithinGroupOrder() ColumnListExpression { return sfe.withinGroup }
+
+func (sfe sqlFunctionExpression) HasWithinGroup() bool {
+	return sfe.withinGroup != nil && !sfe.withinGroup.IsEmpty()
+}
+
 func (sfe sqlFunctionExpression) As(val interface{}) AliasedExpression {
 	return NewAliasExpression(sfe, val)
 }
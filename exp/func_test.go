@@ -34,6 +34,36 @@ func (sfes *sqlFunctionExpressionSuite) TestName() {
 	sfes.Equal("COUNT", sfes.fn.Name())
 }
 
+func (sfes *sqlFunctionExpressionSuite) TestFilter() {
+	sfes.False(sfes.fn.HasFilter())
+	sfes.Nil(sfes.fn.FilterClause())
+	sfes.Equal(sfes.fn, sfes.fn.Filter())
+
+	a := exp.NewIdentifierExpression("", "", "a").Eq(1)
+	b := exp.NewIdentifierExpression("", "", "b").Eq(2)
+	fn := sfes.fn.Filter(a)
+	sfes.False(sfes.fn.HasFilter())
+	sfes.True(fn.HasFilter())
+	sfes.Equal(exp.NewExpressionList(exp.AndType, a), fn.FilterClause())
+	sfes.Equal(exp.NewExpressionList(exp.AndType, a, b), fn.Filter(b).FilterClause())
+	sfes.Equal(fn, fn.Clone())
+}
+
+func (sfes *sqlFunctionExpressionSuite) TestWithinGroup() {
+	sfes.False(sfes.fn.HasWithinGroup())
+	sfes.Nil(sfes.fn.WithinGroupOrder())
+
+	a := exp.NewIdentifierExpression("", "", "a").Asc()
+	b := exp.NewIdentifierExpression("", "", "b").Desc()
+	fn := sfes.fn.WithinGroup(a)
+	sfes.False(sfes.fn.HasWithinGroup())
+	sfes.True(fn.HasWithinGroup())
+	sfes.Equal(exp.NewOrderedColumnList(a), fn.WithinGroupOrder())
+	sfes.Equal(exp.NewOrderedColumnList(b), fn.WithinGroup(b).WithinGroupOrder())
+	sfes.False(fn.WithinGroup().HasWithinGroup())
+	sfes.Equal(fn, fn.Clone())
+}
+
 func (sfes *sqlFunctionExpressionSuite) TestAllOthers() {
 	fn := sfes.fn
 
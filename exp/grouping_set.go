@@ -0,0 +1,62 @@
+package exp
+
+import "fmt"
+
+type groupingSet struct {
+	setType  GroupingSetType
+	elements []ColumnListExpression
+}
+
+const (
+	RollupGroupingSetType GroupingSetType = iota
+	CubeGroupingSetType
+	GroupingSetsGroupingSetType
+)
+
+// Creates a new grouping set. Each element may be a string, an Expression, a []string or []interface{} for a
+// group of columns or a ColumnListExpression
+func NewGroupingSetExpression(setType GroupingSetType, elements ...interface{}) GroupingSetExpression {
+	gs := groupingSet{setType: setType, elements: make([]ColumnListExpression, 0, len(elements))}
+	for _, e := range elements {
+		gs.elements = append(gs.elements, newGroupingElement(e))
+	}
+	return gs
+}
+
+func newGroupingElement(element interface{}) ColumnListExpression {
+	switch t := element.(type) {
+	case ColumnListExpression:
+		return t
+	case []string:
+		cols := make([]interface{}, 0, len(t))
+		for _, col := range t {
+			cols = append(cols, col)
+		}
+		return NewColumnListExpression(cols...)
+	case []interface{}:
+		return NewColumnListExpression(t...)
+	}
+	return NewColumnListExpression(element)
+}
+
+func (gs groupingSet) Clone() Expression {
+	return groupingSet{setType: gs.setType, elements: gs.elements}
+}
+
+func (gs groupingSet) Expression() Expression { return gs }
+
+func (gs groupingSet) Type() GroupingSetType { return gs.setType }
+
+func (gs groupingSet) Elements() []ColumnListExpression { return gs.elements }
+
+func (gst GroupingSetType) String() string {
+	switch gst {
+	case RollupGroupingSetType:
+		return "ROLLUP"
+	case CubeGroupingSetType:
+		return "CUBE"
+	case GroupingSetsGroupingSetType:
+		return "GROUPING SETS"
+	}
+	return fmt.Sprintf("%d", gst)
+}
@@ -0,0 +1,56 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type groupingSetExpressionSuite struct {
+	suite.Suite
+}
+
+func TestGroupingSetExpressionSuite(t *testing.T) {
+	suite.Run(t, new(groupingSetExpressionSuite))
+}
+
+func (gses *groupingSetExpressionSuite) TestType() {
+	gses.Equal(exp.RollupGroupingSetType, exp.NewGroupingSetExpression(exp.RollupGroupingSetType, "a").Type())
+	gses.Equal(exp.CubeGroupingSetType, exp.NewGroupingSetExpression(exp.CubeGroupingSetType, "a").Type())
+	gses.Equal(
+		exp.GroupingSetsGroupingSetType,
+		exp.NewGroupingSetExpression(exp.GroupingSetsGroupingSetType, "a").Type(),
+	)
+}
+
+func (gses *groupingSetExpressionSuite) TestElements() {
+	gs := exp.NewGroupingSetExpression(
+		exp.GroupingSetsGroupingSetType,
+		"a",
+		[]string{"a", "b"},
+		[]interface{}{"b", exp.NewIdentifierExpression("", "", "c")},
+		exp.NewColumnListExpression("c"),
+		[]string{},
+	)
+	gses.Equal([]exp.ColumnListExpression{
+		exp.NewColumnListExpression("a"),
+		exp.NewColumnListExpression("a", "b"),
+		exp.NewColumnListExpression("b", "c"),
+		exp.NewColumnListExpression("c"),
+		exp.NewColumnListExpression(),
+	}, gs.Elements())
+}
+
+func (gses *groupingSetExpressionSuite) TestClone() {
+	gs := exp.NewGroupingSetExpression(exp.RollupGroupingSetType, "a", "b")
+	gses.Equal(gs, gs.Clone())
+	gses.Equal(gs, gs.Expression())
+}
+
+func (gses *groupingSetExpressionSuite) TestString() {
+	gses.Equal("ROLLUP", exp.RollupGroupingSetType.String())
+	gses.Equal("CUBE", exp.CubeGroupingSetType.String())
+	gses.Equal("GROUPING SETS", exp.GroupingSetsGroupingSetType.String())
+	gses.Equal("10", exp.GroupingSetType(10).String())
+}
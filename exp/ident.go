@@ -209,6 +209,12 @@ func (i identifier) Desc() OrderedExpression                          { return d
 func (i identifier) Distinct() SQLFunctionExpression                  { return NewSQLFunctionExpression("DISTINCT", i) }
 func (i identifier) Cast(t string) CastExpression                     { return NewCastExpression(i, t) }
 
+// Returns the JSON operators for the identifier (e.g I("doc").JSON().Get("a") -> "doc"->'a')
+func (i identifier) JSON() JSONOperators { return NewJSONOperators(i) }
+
+// Returns the array operators for the identifier (e.g I("tags").Array().Contains([]string{"a"}) -> "tags" @> ARRAY['a'])
+func (i identifier) Array() ArrayOperators { return NewArrayOperators(i) }
+
 // Returns a RangeExpression for checking that a identifier is between two values (e.g "my_col" BETWEEN 1 AND 10)
 func (i identifier) Between(val RangeVal) RangeExpression { return between(i, val) }
 
@@ -239,6 +239,8 @@ func (ies *identifierExpressionSuite) TestAllOthers() {
 		{Ex: ident.BitwiseXor(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseXorOp, ident, bitwiseVals)},
 		{Ex: ident.BitwiseLeftShift(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseLeftShiftOp, ident, bitwiseVals)},
 		{Ex: ident.BitwiseRightShift(bitwiseVals), Expected: exp.NewBitwiseExpression(exp.BitwiseRightShiftOp, ident, bitwiseVals)},
+		{Ex: ident.JSON().Get("a"), Expected: exp.NewJSONExpression(exp.JSONGetOp, ident, "a")},
+		{Ex: ident.Array().Contains(inVals), Expected: exp.NewArrayExpression(exp.ArrayContainsOp, ident, inVals)},
 	}
 
 	for _, tc := range testCases {
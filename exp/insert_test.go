@@ -237,6 +237,24 @@ func (iets *insertExpressionTestSuite) TestNewInsertExpression_withStructsWithGo
 	iets.False(ie.IsInsertFrom())
 }
 
+func (iets *insertExpressionTestSuite) TestNewInsertExpression_withStructsWithGoquSensitive() {
+	type testRecord struct {
+		FieldA int64
+		FieldB string `goqu:"sensitive"`
+		FieldC string `goqu:"sensitive,defaultifempty"`
+	}
+	ie, err := exp.NewInsertExpression(
+		testRecord{FieldA: 1, FieldB: "a", FieldC: "c"},
+		testRecord{FieldA: 2, FieldB: "b"},
+	)
+	iets.NoError(err)
+	iets.Equal(exp.NewColumnListExpression("fielda", "fieldb", "fieldc"), ie.Cols())
+	iets.Equal([][]interface{}{
+		{int64(1), exp.NewSensitiveExpression("a"), exp.NewSensitiveExpression("c")},
+		{int64(2), exp.NewSensitiveExpression("b"), exp.Default()},
+	}, ie.Vals())
+}
+
 func (iets *insertExpressionTestSuite) TestNewInsertExpression_withStructPointers() {
 	type testRecord struct {
 		C string `db:"c"`
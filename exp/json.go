@@ -0,0 +1,113 @@
+package exp
+
+type (
+	jsonExp struct {
+		lhs Expression
+		rhs interface{}
+		op  JSONOperation
+	}
+	jsonOps struct {
+		lhs Expression
+	}
+)
+
+func NewJSONExpression(op JSONOperation, lhs Expression, rhs interface{}) JSONExpression {
+	return jsonExp{op: op, lhs: lhs, rhs: rhs}
+}
+
+// Creates the JSON operators for an expression (e.g. NewJSONOperators(I("doc")).Get("a") -> "doc"->'a')
+func NewJSONOperators(lhs Expression) JSONOperators {
+	return jsonOps{lhs: lhs}
+}
+
+func (j jsonExp) Clone() Expression {
+	return NewJSONExpression(j.op, j.lhs.Clone(), j.rhs)
+}
+
+func (j jsonExp) RHS() interface{} {
+	return j.rhs
+}
+
+func (j jsonExp) LHS() Expression {
+	return j.lhs
+}
+
+func (j jsonExp) Op() JSONOperation {
+	return j.op
+}
+
+func (j jsonExp) Expression() Expression                           { return j }
+func (j jsonExp) As(val interface{}) AliasedExpression             { return NewAliasExpression(j, val) }
+func (j jsonExp) Eq(val interface{}) BooleanExpression             { return eq(j, val) }
+func (j jsonExp) Neq(val interface{}) BooleanExpression            { return neq(j, val) }
+func (j jsonExp) Gt(val interface{}) BooleanExpression             { return gt(j, val) }
+func (j jsonExp) Gte(val interface{}) BooleanExpression            { return gte(j, val) }
+func (j jsonExp) Lt(val interface{}) BooleanExpression             { return lt(j, val) }
+func (j jsonExp) Lte(val interface{}) BooleanExpression            { return lte(j, val) }
+func (j jsonExp) Asc() OrderedExpression                           { return asc(j) }
+func (j jsonExp) Desc() OrderedExpression                          { return desc(j) }
+func (j jsonExp) Like(i interface{}) BooleanExpression             { return like(j, i) }
+func (j jsonExp) NotLike(i interface{}) BooleanExpression          { return notLike(j, i) }
+func (j jsonExp) ILike(i interface{}) BooleanExpression            { return iLike(j, i) }
+func (j jsonExp) NotILike(i interface{}) BooleanExpression         { return notILike(j, i) }
+func (j jsonExp) RegexpLike(val interface{}) BooleanExpression     { return regexpLike(j, val) }
+func (j jsonExp) RegexpNotLike(val interface{}) BooleanExpression  { return regexpNotLike(j, val) }
+func (j jsonExp) RegexpILike(val interface{}) BooleanExpression    { return regexpILike(j, val) }
+func (j jsonExp) RegexpNotILike(val interface{}) BooleanExpression { return regexpNotILike(j, val) }
+func (j jsonExp) In(i ...interface{}) BooleanExpression            { return in(j, i...) }
+func (j jsonExp) NotIn(i ...interface{}) BooleanExpression         { return notIn(j, i...) }
+func (j jsonExp) Is(i interface{}) BooleanExpression               { return is(j, i) }
+func (j jsonExp) IsNot(i interface{}) BooleanExpression            { return isNot(j, i) }
+func (j jsonExp) IsNull() BooleanExpression                        { return is(j, nil) }
+func (j jsonExp) IsNotNull() BooleanExpression                     { return isNot(j, nil) }
+func (j jsonExp) IsTrue() BooleanExpression                        { return is(j, true) }
+func (j jsonExp) IsNotTrue() BooleanExpression                     { return isNot(j, true) }
+func (j jsonExp) IsFalse() BooleanExpression                       { return is(j, false) }
+func (j jsonExp) IsNotFalse() BooleanExpression                    { return isNot(j, false) }
+func (j jsonExp) Distinct() SQLFunctionExpression                  { return NewSQLFunctionExpression("DISTINCT", j) }
+func (j jsonExp) Between(val RangeVal) RangeExpression             { return between(j, val) }
+func (j jsonExp) NotBetween(val RangeVal) RangeExpression          { return notBetween(j, val) }
+func (j jsonExp) Cast(t string) CastExpression                     { return NewCastExpression(j, t) }
+func (j jsonExp) Get(key interface{}) JSONExpression               { return jsonGet(j, key) }
+func (j jsonExp) GetText(key interface{}) JSONExpression           { return jsonGetText(j, key) }
+func (j jsonExp) Path(path ...interface{}) JSONExpression          { return jsonPath(j, path) }
+func (j jsonExp) PathText(path ...interface{}) JSONExpression      { return jsonPathText(j, path) }
+func (j jsonExp) Contains(val interface{}) JSONExpression          { return jsonContains(j, val) }
+func (j jsonExp) HasKey(key string) JSONExpression                 { return jsonHasKey(j, key) }
+
+func (jo jsonOps) Get(key interface{}) JSONExpression          { return jsonGet(jo.lhs, key) }
+func (jo jsonOps) GetText(key interface{}) JSONExpression      { return jsonGetText(jo.lhs, key) }
+func (jo jsonOps) Path(path ...interface{}) JSONExpression     { return jsonPath(jo.lhs, path) }
+func (jo jsonOps) PathText(path ...interface{}) JSONExpression { return jsonPathText(jo.lhs, path) }
+func (jo jsonOps) Contains(val interface{}) JSONExpression     { return jsonContains(jo.lhs, val) }
+func (jo jsonOps) HasKey(key string) JSONExpression            { return jsonHasKey(jo.lhs, key) }
+
+// used internally to create a JSON Get JSONExpression
+func jsonGet(lhs Expression, key interface{}) JSONExpression {
+	return NewJSONExpression(JSONGetOp, lhs, key)
+}
+
+// used internally to create a JSON Get Text JSONExpression
+func jsonGetText(lhs Expression, key interface{}) JSONExpression {
+	return NewJSONExpression(JSONGetTextOp, lhs, key)
+}
+
+// used internally to create a JSON Path JSONExpression
+func jsonPath(lhs Expression, path []interface{}) JSONExpression {
+	return NewJSONExpression(JSONPathOp, lhs, path)
+}
+
+// used internally to create a JSON Path Text JSONExpression
+func jsonPathText(lhs Expression, path []interface{}) JSONExpression {
+	return NewJSONExpression(JSONPathTextOp, lhs, path)
+}
+
+// used internally to create a JSON Contains JSONExpression
+func jsonContains(lhs Expression, val interface{}) JSONExpression {
+	return NewJSONExpression(JSONContainsOp, lhs, val)
+}
+
+// used internally to create a JSON Has Key JSONExpression
+func jsonHasKey(lhs Expression, key string) JSONExpression {
+	return NewJSONExpression(JSONHasKeyOp, lhs, key)
+}
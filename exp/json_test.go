@@ -0,0 +1,133 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type jsonExpressionSuite struct {
+	suite.Suite
+}
+
+func TestJSONExpressionSuite(t *testing.T) {
+	suite.Run(t, &jsonExpressionSuite{})
+}
+
+func (jes *jsonExpressionSuite) TestClone() {
+	je := exp.NewJSONExpression(exp.JSONGetOp, exp.NewIdentifierExpression("", "", "doc"), "a")
+	jes.Equal(je, je.Clone())
+}
+
+func (jes *jsonExpressionSuite) TestExpression() {
+	je := exp.NewJSONExpression(exp.JSONGetOp, exp.NewIdentifierExpression("", "", "doc"), "a")
+	jes.Equal(je, je.Expression())
+}
+
+func (jes *jsonExpressionSuite) TestAs() {
+	je := exp.NewJSONExpression(exp.JSONGetTextOp, exp.NewIdentifierExpression("", "", "doc"), "a")
+	jes.Equal(exp.NewAliasExpression(je, "a"), je.As("a"))
+}
+
+func (jes *jsonExpressionSuite) TestAsc() {
+	je := exp.NewJSONExpression(exp.JSONGetOp, exp.NewIdentifierExpression("", "", "doc"), "a")
+	jes.Equal(exp.NewOrderedExpression(je, exp.AscDir, exp.NoNullsSortType), je.Asc())
+}
+
+func (jes *jsonExpressionSuite) TestDesc() {
+	je := exp.NewJSONExpression(exp.JSONGetOp, exp.NewIdentifierExpression("", "", "doc"), "a")
+	jes.Equal(exp.NewOrderedExpression(je, exp.DescSortDir, exp.NoNullsSortType), je.Desc())
+}
+
+func (jes *jsonExpressionSuite) TestCast() {
+	je := exp.NewJSONExpression(exp.JSONGetTextOp, exp.NewIdentifierExpression("", "", "doc"), "a")
+	jes.Equal(exp.NewCastExpression(je, "INT"), je.Cast("INT"))
+}
+
+func (jes *jsonExpressionSuite) TestOperators() {
+	ident := exp.NewIdentifierExpression("", "", "doc")
+	je := exp.NewJSONExpression(exp.JSONGetOp, ident, "a")
+	val := map[string]interface{}{"a": 1}
+	testCases := []struct {
+		Ex       exp.JSONExpression
+		Expected exp.JSONExpression
+	}{
+		{Ex: ident.JSON().Get("a"), Expected: exp.NewJSONExpression(exp.JSONGetOp, ident, "a")},
+		{Ex: ident.JSON().GetText(1), Expected: exp.NewJSONExpression(exp.JSONGetTextOp, ident, 1)},
+		{Ex: ident.JSON().Path("a", 1), Expected: exp.NewJSONExpression(exp.JSONPathOp, ident, []interface{}{"a", 1})},
+		{
+			Ex:       ident.JSON().PathText("a", 1),
+			Expected: exp.NewJSONExpression(exp.JSONPathTextOp, ident, []interface{}{"a", 1}),
+		},
+		{Ex: ident.JSON().Contains(val), Expected: exp.NewJSONExpression(exp.JSONContainsOp, ident, val)},
+		{Ex: ident.JSON().HasKey("a"), Expected: exp.NewJSONExpression(exp.JSONHasKeyOp, ident, "a")},
+
+		{Ex: je.Get("b"), Expected: exp.NewJSONExpression(exp.JSONGetOp, je, "b")},
+		{Ex: je.GetText("b"), Expected: exp.NewJSONExpression(exp.JSONGetTextOp, je, "b")},
+		{Ex: je.Path("b", "c"), Expected: exp.NewJSONExpression(exp.JSONPathOp, je, []interface{}{"b", "c"})},
+		{Ex: je.PathText("b", "c"), Expected: exp.NewJSONExpression(exp.JSONPathTextOp, je, []interface{}{"b", "c"})},
+		{Ex: je.Contains(val), Expected: exp.NewJSONExpression(exp.JSONContainsOp, je, val)},
+		{Ex: je.HasKey("b"), Expected: exp.NewJSONExpression(exp.JSONHasKeyOp, je, "b")},
+	}
+
+	for _, tc := range testCases {
+		jes.Equal(tc.Expected, tc.Ex)
+		jes.Equal(tc.Expected.Op(), tc.Ex.Op())
+		jes.Equal(tc.Expected.LHS(), tc.Ex.LHS())
+		jes.Equal(tc.Expected.RHS(), tc.Ex.RHS())
+	}
+}
+
+func (jes *jsonExpressionSuite) TestAllOthers() {
+	je := exp.NewJSONExpression(exp.JSONGetTextOp, exp.NewIdentifierExpression("", "", "doc"), "a")
+	rv := exp.NewRangeVal(1, 2)
+	pattern := "jsonExp like%"
+	inVals := []interface{}{1, 2}
+	testCases := []struct {
+		Ex       exp.Expression
+		Expected exp.Expression
+	}{
+		{Ex: je.Eq(1), Expected: exp.NewBooleanExpression(exp.EqOp, je, 1)},
+		{Ex: je.Neq(1), Expected: exp.NewBooleanExpression(exp.NeqOp, je, 1)},
+		{Ex: je.Gt(1), Expected: exp.NewBooleanExpression(exp.GtOp, je, 1)},
+		{Ex: je.Gte(1), Expected: exp.NewBooleanExpression(exp.GteOp, je, 1)},
+		{Ex: je.Lt(1), Expected: exp.NewBooleanExpression(exp.LtOp, je, 1)},
+		{Ex: je.Lte(1), Expected: exp.NewBooleanExpression(exp.LteOp, je, 1)},
+		{Ex: je.Between(rv), Expected: exp.NewRangeExpression(exp.BetweenOp, je, rv)},
+		{Ex: je.NotBetween(rv), Expected: exp.NewRangeExpression(exp.NotBetweenOp, je, rv)},
+		{Ex: je.Like(pattern), Expected: exp.NewBooleanExpression(exp.LikeOp, je, pattern)},
+		{Ex: je.NotLike(pattern), Expected: exp.NewBooleanExpression(exp.NotLikeOp, je, pattern)},
+		{Ex: je.ILike(pattern), Expected: exp.NewBooleanExpression(exp.ILikeOp, je, pattern)},
+		{Ex: je.NotILike(pattern), Expected: exp.NewBooleanExpression(exp.NotILikeOp, je, pattern)},
+		{Ex: je.RegexpLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpLikeOp, je, pattern)},
+		{Ex: je.RegexpNotLike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotLikeOp, je, pattern)},
+		{Ex: je.RegexpILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpILikeOp, je, pattern)},
+		{Ex: je.RegexpNotILike(pattern), Expected: exp.NewBooleanExpression(exp.RegexpNotILikeOp, je, pattern)},
+		{Ex: je.In(inVals), Expected: exp.NewBooleanExpression(exp.InOp, je, inVals)},
+		{Ex: je.NotIn(inVals), Expected: exp.NewBooleanExpression(exp.NotInOp, je, inVals)},
+		{Ex: je.Is(true), Expected: exp.NewBooleanExpression(exp.IsOp, je, true)},
+		{Ex: je.IsNot(true), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, true)},
+		{Ex: je.IsNull(), Expected: exp.NewBooleanExpression(exp.IsOp, je, nil)},
+		{Ex: je.IsNotNull(), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, nil)},
+		{Ex: je.IsTrue(), Expected: exp.NewBooleanExpression(exp.IsOp, je, true)},
+		{Ex: je.IsNotTrue(), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, true)},
+		{Ex: je.IsFalse(), Expected: exp.NewBooleanExpression(exp.IsOp, je, false)},
+		{Ex: je.IsNotFalse(), Expected: exp.NewBooleanExpression(exp.IsNotOp, je, false)},
+		{Ex: je.Distinct(), Expected: exp.NewSQLFunctionExpression("DISTINCT", je)},
+	}
+
+	for _, tc := range testCases {
+		jes.Equal(tc.Expected, tc.Ex)
+	}
+}
+
+func (jes *jsonExpressionSuite) TestJSONOperation_String() {
+	jes.Equal("get", exp.JSONGetOp.String())
+	jes.Equal("get text", exp.JSONGetTextOp.String())
+	jes.Equal("path", exp.JSONPathOp.String())
+	jes.Equal("path text", exp.JSONPathTextOp.String())
+	jes.Equal("contains", exp.JSONContainsOp.String())
+	jes.Equal("has key", exp.JSONHasKeyOp.String())
+	jes.Equal("100", exp.JSONOperation(100).String())
+}
@@ -0,0 +1,106 @@
+package exp
+
+import "fmt"
+
+type (
+	mergeAction struct {
+		actionType  MergeActionType
+		values      interface{}
+		whereClause ExpressionList
+	}
+	mergeWhen struct {
+		whenType MergeWhenType
+		action   MergeAction
+	}
+)
+
+// Creates a new MergeAction. The values are only used by UPDATE and INSERT actions
+//
+//	NewMergeAction(UpdateMergeAction, Record{"a": "b"}) -> UPDATE SET "a"='b'
+//	NewMergeAction(InsertMergeAction, Record{"a": "b"}) -> INSERT ("a") VALUES ('b')
+//	NewMergeAction(DeleteMergeAction, nil) -> DELETE
+//	NewMergeAction(DoNothingMergeAction, nil) -> DO NOTHING
+func NewMergeAction(actionType MergeActionType, values interface{}) MergeAction {
+	return mergeAction{actionType: actionType, values: values}
+}
+
+func (ma mergeAction) Expression() Expression {
+	return ma
+}
+
+func (ma mergeAction) Clone() Expression {
+	return mergeAction{actionType: ma.actionType, values: ma.values, whereClause: ma.whereClause}
+}
+
+func (ma mergeAction) Type() MergeActionType {
+	return ma.actionType
+}
+
+func (ma mergeAction) Values() interface{} {
+	return ma.values
+}
+
+// Append to the existing conditions of the action
+//
+//	MergeDelete().Where(I("a").Eq(1)) -> WHEN MATCHED AND ("a" = 1) THEN DELETE
+func (ma mergeAction) Where(expressions ...Expression) MergeAction {
+	if len(expressions) == 0 {
+		return ma
+	}
+	ret := ma
+	if ret.whereClause == nil {
+		ret.whereClause = NewExpressionList(AndType, expressions...)
+	} else {
+		ret.whereClause = ret.whereClause.Append(expressions...)
+	}
+	return ret
+}
+
+func (ma mergeAction) WhereClause() ExpressionList {
+	return ma.whereClause
+}
+
+// Creates a new WHEN MATCHED or WHEN NOT MATCHED clause for a MERGE statement
+func NewMergeWhenExpression(whenType MergeWhenType, action MergeAction) MergeWhenExpression {
+	return mergeWhen{whenType: whenType, action: action}
+}
+
+func (mw mergeWhen) Expression() Expression {
+	return mw
+}
+
+func (mw mergeWhen) Clone() Expression {
+	return mergeWhen{whenType: mw.whenType, action: mw.action}
+}
+
+func (mw mergeWhen) Type() MergeWhenType {
+	return mw.whenType
+}
+
+func (mw mergeWhen) Action() MergeAction {
+	return mw.action
+}
+
+func (mwt MergeWhenType) String() string {
+	switch mwt {
+	case WhenMatchedMergeType:
+		return "WHEN MATCHED"
+	case WhenNotMatchedMergeType:
+		return "WHEN NOT MATCHED"
+	}
+	return fmt.Sprintf("%d", mwt)
+}
+
+func (mat MergeActionType) String() string {
+	switch mat {
+	case UpdateMergeAction:
+		return "UPDATE"
+	case DeleteMergeAction:
+		return "DELETE"
+	case InsertMergeAction:
+		return "INSERT"
+	case DoNothingMergeAction:
+		return "DO NOTHING"
+	}
+	return fmt.Sprintf("%d", mat)
+}
@@ -0,0 +1,119 @@
+package exp
+
+type (
+	MergeClauses interface {
+		clone() *mergeClauses
+
+		CommonTables() []CommonTableExpression
+		CommonTablesAppend(cte CommonTableExpression) MergeClauses
+
+		HasInto() bool
+		Into() Expression
+		SetInto(target Expression) MergeClauses
+
+		HasUsing() bool
+		Using() Expression
+		SetUsing(source Expression) MergeClauses
+
+		On() ExpressionList
+		ClearOn() MergeClauses
+		OnAppend(expressions ...Expression) MergeClauses
+
+		Whens() []MergeWhenExpression
+		WhensAppend(whens ...MergeWhenExpression) MergeClauses
+	}
+	mergeClauses struct {
+		commonTables []CommonTableExpression
+		into         Expression
+		using        Expression
+		on           ExpressionList
+		whens        []MergeWhenExpression
+	}
+)
+
+func NewMergeClauses() MergeClauses {
+	return &mergeClauses{}
+}
+
+func (mc *mergeClauses) clone() *mergeClauses {
+	return &mergeClauses{
+		commonTables: mc.commonTables,
+		into:         mc.into,
+		using:        mc.using,
+		on:           mc.on,
+		whens:        mc.whens,
+	}
+}
+
+func (mc *mergeClauses) CommonTables() []CommonTableExpression {
+	return mc.commonTables
+}
+
+func (mc *mergeClauses) CommonTablesAppend(cte CommonTableExpression) MergeClauses {
+	ret := mc.clone()
+	ret.commonTables = append(ret.commonTables, cte)
+	return ret
+}
+
+func (mc *mergeClauses) HasInto() bool {
+	return mc.into != nil
+}
+
+func (mc *mergeClauses) Into() Expression {
+	return mc.into
+}
+
+func (mc *mergeClauses) SetInto(target Expression) MergeClauses {
+	ret := mc.clone()
+	ret.into = target
+	return ret
+}
+
+func (mc *mergeClauses) HasUsing() bool {
+	return mc.using != nil
+}
+
+func (mc *mergeClauses) Using() Expression {
+	return mc.using
+}
+
+func (mc *mergeClauses) SetUsing(source Expression) MergeClauses {
+	ret := mc.clone()
+	ret.using = source
+	return ret
+}
+
+func (mc *mergeClauses) On() ExpressionList {
+	return mc.on
+}
+
+func (mc *mergeClauses) ClearOn() MergeClauses {
+	ret := mc.clone()
+	ret.on = nil
+	return ret
+}
+
+func (mc *mergeClauses) OnAppend(expressions ...Expression) MergeClauses {
+	if len(expressions) == 0 {
+		return mc
+	}
+	ret := mc.clone()
+	if ret.on == nil {
+		ret.on = NewExpressionList(AndType, expressions...)
+	} else {
+		ret.on = ret.on.Append(expressions...)
+	}
+	return ret
+}
+
+func (mc *mergeClauses) Whens() []MergeWhenExpression {
+	return mc.whens
+}
+
+func (mc *mergeClauses) WhensAppend(whens ...MergeWhenExpression) MergeClauses {
+	ret := mc.clone()
+	ret.whens = make([]MergeWhenExpression, 0, len(mc.whens)+len(whens))
+	ret.whens = append(ret.whens, mc.whens...)
+	ret.whens = append(ret.whens, whens...)
+	return ret
+}
@@ -0,0 +1,95 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type mergeClausesSuite struct {
+	suite.Suite
+}
+
+func TestMergeClausesSuite(t *testing.T) {
+	suite.Run(t, new(mergeClausesSuite))
+}
+
+func (mcs *mergeClausesSuite) TestCommonTables() {
+	cte := exp.NewCommonTableExpression(true, "test", newTestAppendableExpression(`SELECT * FROM "foo"`, []interface{}{}))
+
+	c := exp.NewMergeClauses()
+	c2 := c.CommonTablesAppend(cte)
+
+	mcs.Nil(c.CommonTables())
+
+	mcs.Equal([]exp.CommonTableExpression{cte}, c2.CommonTables())
+}
+
+func (mcs *mergeClausesSuite) TestInto() {
+	c := exp.NewMergeClauses()
+	ti := exp.NewIdentifierExpression("", "a", "")
+	c2 := c.SetInto(ti)
+
+	mcs.False(c.HasInto())
+	mcs.Nil(c.Into())
+
+	mcs.True(c2.HasInto())
+	mcs.Equal(ti, c2.Into())
+}
+
+func (mcs *mergeClausesSuite) TestUsing() {
+	c := exp.NewMergeClauses()
+	ti := exp.NewIdentifierExpression("", "b", "")
+	c2 := c.SetUsing(ti)
+
+	mcs.False(c.HasUsing())
+	mcs.Nil(c.Using())
+
+	mcs.True(c2.HasUsing())
+	mcs.Equal(ti, c2.Using())
+}
+
+func (mcs *mergeClausesSuite) TestOnAppend() {
+	on := exp.Ex{"a": 1}
+	on2 := exp.Ex{"b": 2}
+
+	c := exp.NewMergeClauses()
+	c2 := c.OnAppend(on)
+	c3 := c.OnAppend(on).OnAppend(on2)
+	c4 := c.OnAppend(on, on2)
+	c5 := c.OnAppend()
+
+	mcs.Nil(c.On())
+	mcs.Equal(exp.NewExpressionList(exp.AndType, on), c2.On())
+	mcs.Equal(exp.NewExpressionList(exp.AndType, on).Append(on2), c3.On())
+	mcs.Equal(exp.NewExpressionList(exp.AndType, on, on2), c4.On())
+	mcs.Nil(c5.On())
+}
+
+func (mcs *mergeClausesSuite) TestClearOn() {
+	on := exp.Ex{"a": 1}
+
+	c := exp.NewMergeClauses().OnAppend(on)
+	c2 := c.ClearOn()
+
+	mcs.Equal(exp.NewExpressionList(exp.AndType, on), c.On())
+
+	mcs.Nil(c2.On())
+}
+
+func (mcs *mergeClausesSuite) TestWhensAppend() {
+	w := exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, exp.NewMergeAction(exp.DeleteMergeAction, nil))
+	w2 := exp.NewMergeWhenExpression(
+		exp.WhenNotMatchedMergeType,
+		exp.NewMergeAction(exp.InsertMergeAction, exp.Record{"a": 1}),
+	)
+
+	c := exp.NewMergeClauses()
+	c2 := c.WhensAppend(w)
+	c3 := c2.WhensAppend(w2)
+
+	mcs.Nil(c.Whens())
+	mcs.Equal([]exp.MergeWhenExpression{w}, c2.Whens())
+	mcs.Equal([]exp.MergeWhenExpression{w, w2}, c3.Whens())
+}
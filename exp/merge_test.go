@@ -0,0 +1,79 @@
+package exp_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type mergeExpressionSuite struct {
+	suite.Suite
+}
+
+func TestMergeExpressionSuite(t *testing.T) {
+	suite.Run(t, new(mergeExpressionSuite))
+}
+
+func (mes *mergeExpressionSuite) TestMergeTypeZeroValues() {
+	var (
+		whenType   exp.MergeWhenType
+		actionType exp.MergeActionType
+	)
+	mes.Equal(exp.WhenMatchedMergeType, whenType)
+	mes.Equal(exp.UpdateMergeAction, actionType)
+	// the merge types must not change the values of the constants declared before them
+	mes.Equal(exp.ExpressionListType(6), exp.AndType)
+}
+
+func (mes *mergeExpressionSuite) TestMergeAction() {
+	r := exp.Record{"a": "b"}
+	ma := exp.NewMergeAction(exp.UpdateMergeAction, r)
+	mes.Equal(exp.UpdateMergeAction, ma.Type())
+	mes.Equal(r, ma.Values())
+	mes.Nil(ma.WhereClause())
+	mes.Equal(ma, ma.Expression())
+	mes.Equal(ma, ma.Clone())
+
+	ma = exp.NewMergeAction(exp.DeleteMergeAction, nil)
+	mes.Equal(exp.DeleteMergeAction, ma.Type())
+	mes.Nil(ma.Values())
+}
+
+func (mes *mergeExpressionSuite) TestMergeAction_Where() {
+	w := exp.Ex{"a": 1}
+	w2 := exp.Ex{"b": 2}
+
+	ma := exp.NewMergeAction(exp.DeleteMergeAction, nil)
+	ma2 := ma.Where(w)
+	ma3 := ma2.Where(w2)
+
+	mes.Equal(ma, ma.Where())
+	mes.Nil(ma.WhereClause())
+	mes.Equal(exp.NewExpressionList(exp.AndType, w), ma2.WhereClause())
+	mes.Equal(exp.NewExpressionList(exp.AndType, w).Append(w2), ma3.WhereClause())
+	mes.Equal(ma3, ma3.Clone())
+}
+
+func (mes *mergeExpressionSuite) TestMergeWhenExpression() {
+	ma := exp.NewMergeAction(exp.InsertMergeAction, exp.Record{"a": "b"})
+	mw := exp.NewMergeWhenExpression(exp.WhenNotMatchedMergeType, ma)
+	mes.Equal(exp.WhenNotMatchedMergeType, mw.Type())
+	mes.Equal(ma, mw.Action())
+	mes.Equal(mw, mw.Expression())
+	mes.Equal(mw, mw.Clone())
+}
+
+func (mes *mergeExpressionSuite) TestMergeWhenType_String() {
+	mes.Equal("WHEN MATCHED", exp.WhenMatchedMergeType.String())
+	mes.Equal("WHEN NOT MATCHED", exp.WhenNotMatchedMergeType.String())
+	mes.Equal("100", exp.MergeWhenType(100).String())
+}
+
+func (mes *mergeExpressionSuite) TestMergeActionType_String() {
+	mes.Equal("UPDATE", exp.UpdateMergeAction.String())
+	mes.Equal("DELETE", exp.DeleteMergeAction.String())
+	mes.Equal("INSERT", exp.InsertMergeAction.String())
+	mes.Equal("DO NOTHING", exp.DoNothingMergeAction.String())
+	mes.Equal("100", exp.MergeActionType(100).String())
+}
@@ -58,6 +58,14 @@ func shouldOmitField(val reflect.Value, f util.ColumnData) bool {
 }
 
 func getRecordValue(val reflect.Value, f util.ColumnData) interface{} {
+	value := getFieldValue(val, f)
+	if _, isExpression := value.(Expression); f.Sensitive && !isExpression {
+		return NewSensitiveExpression(value)
+	}
+	return value
+}
+
+func getFieldValue(val reflect.Value, f util.ColumnData) interface{} {
 	if f.DefaultIfEmpty && util.IsEmptyValue(val) {
 		return Default()
 	} else if val.IsValid() {
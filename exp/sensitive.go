@@ -0,0 +1,65 @@
+package exp
+
+import "fmt"
+
+type (
+	sensitive struct {
+		value interface{}
+	}
+	// Used to match values that should be redacted, see RegisterSensitiveValueMatcher.
+	SensitiveValueMatcher func(value interface{}) bool
+)
+
+// The value used in place of a sensitive value when a statement or error is logged or traced.
+const RedactedValue = "[REDACTED]"
+
+var sensitiveValueMatchers []SensitiveValueMatcher
+
+// Creates a new SensitiveExpression. The value is generated as is but redacted when the statement is logged or traced.
+//
+//	NewSensitiveExpression("secret") -> 'secret' ([REDACTED] when logged)
+func NewSensitiveExpression(value interface{}) SensitiveExpression {
+	if s, ok := value.(SensitiveExpression); ok {
+		return s
+	}
+	return sensitive{value: value}
+}
+
+// Registers a matcher used to determine if a value is sensitive. Any value that matches is treated as if it were a
+// SensitiveExpression. Matchers should be registered before any statements are generated.
+func RegisterSensitiveValueMatcher(matcher SensitiveValueMatcher) {
+	sensitiveValueMatchers = append(sensitiveValueMatchers, matcher)
+}
+
+// Returns true if the value is a SensitiveExpression or matches a registered SensitiveValueMatcher.
+func IsSensitiveValue(value interface{}) bool {
+	if _, ok := value.(SensitiveExpression); ok {
+		return true
+	}
+	for _, matcher := range sensitiveValueMatchers {
+		if matcher(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s sensitive) Clone() Expression {
+	return NewSensitiveExpression(s.value)
+}
+
+func (s sensitive) Expression() Expression { return s }
+
+func (s sensitive) Value() interface{} {
+	return s.value
+}
+
+// Returns RedactedValue so the value is not leaked when the expression is formatted
+func (s sensitive) String() string {
+	return RedactedValue
+}
+
+// Formats the expression as RedactedValue for every verb so the value is not leaked when the expression is formatted
+func (s sensitive) Format(f fmt.State, verb rune) {
+	_, _ = f.Write([]byte(RedactedValue))
+}
@@ -0,0 +1,53 @@
+package exp_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/stretchr/testify/suite"
+)
+
+type sensitiveExpressionSuite struct {
+	suite.Suite
+}
+
+func TestSensitiveExpressionSuite(t *testing.T) {
+	suite.Run(t, &sensitiveExpressionSuite{})
+}
+
+func (ses *sensitiveExpressionSuite) TestClone() {
+	se := exp.NewSensitiveExpression("secret")
+	ses.Equal(exp.NewSensitiveExpression("secret"), se.Clone())
+}
+
+func (ses *sensitiveExpressionSuite) TestExpression() {
+	se := exp.NewSensitiveExpression("secret")
+	ses.Equal(se, se.Expression())
+}
+
+func (ses *sensitiveExpressionSuite) TestValue() {
+	ses.Equal("secret", exp.NewSensitiveExpression("secret").Value())
+	ses.Equal("secret", exp.NewSensitiveExpression(exp.NewSensitiveExpression("secret")).Value())
+}
+
+func (ses *sensitiveExpressionSuite) TestFormat() {
+	se := exp.NewSensitiveExpression("secret")
+	ses.Equal(exp.RedactedValue, fmt.Sprintf("%v", se))
+	ses.Equal(exp.RedactedValue, fmt.Sprintf("%+v", se))
+	ses.Equal(exp.RedactedValue, fmt.Sprintf("%s", se))
+	ses.Equal(exp.RedactedValue, fmt.Sprintf("%#v", se))
+}
+
+func (ses *sensitiveExpressionSuite) TestIsSensitiveValue() {
+	exp.RegisterSensitiveValueMatcher(func(value interface{}) bool {
+		s, ok := value.(string)
+		return ok && strings.HasPrefix(s, "exp_sensitive_test_")
+	})
+	ses.True(exp.IsSensitiveValue(exp.NewSensitiveExpression("secret")))
+	ses.True(exp.IsSensitiveValue("exp_sensitive_test_secret"))
+	ses.False(exp.IsSensitiveValue("secret"))
+	ses.False(exp.IsSensitiveValue(1))
+	ses.False(exp.IsSensitiveValue(nil))
+}
@@ -5,6 +5,7 @@ type sqlWindowExpression struct {
 	parent        IdentifierExpression
 	partitionCols ColumnListExpression
 	orderCols     ColumnListExpression
+	frame         WindowFrame
 }
 
 func NewWindowExpression(window, parent IdentifierExpression, partitionCols, orderCols ColumnListExpression) WindowExpression {
@@ -28,6 +29,7 @@ func (we sqlWindowExpression) clone() sqlWindowExpression {
 		parent:        we.parent,
 		partitionCols: we.partitionCols.Clone().(ColumnListExpression),
 		orderCols:     we.orderCols.Clone().(ColumnListExpression),
+		frame:         we.frame,
 	}
 }
 
@@ -88,3 +90,42 @@ func (we sqlWindowExpression) Inherit(parent string) WindowExpression {
 	ret.parent = ParseIdentifier(parent)
 	return ret
 }
+
+func (we sqlWindowExpression) Frame() WindowFrame {
+	return we.frame
+}
+
+func (we sqlWindowExpression) HasFrame() bool {
+	return we.frame != nil
+}
+
+func (we sqlWindowExpression) Rows(start, end WindowFrameBound) WindowExpression {
+	return we.withFrame(RowsFrameUnit, start, end)
+}
+
+func (we sqlWindowExpression) Range(start, end WindowFrameBound) WindowExpression {
+	return we.withFrame(RangeFrameUnit, start, end)
+}
+
+func (we sqlWindowExpression) Groups(start, end WindowFrameBound) WindowExpression {
+	return we.withFrame(GroupsFrameUnit, start, end)
+}
+
+func (we sqlWindowExpression) Exclude(exclusion WindowFrameExclusion) WindowExpression {
+	ret := we.clone()
+	if ret.frame == nil {
+		ret.frame = NewWindowFrame(RowsFrameUnit, nil, nil)
+	}
+	ret.frame = ret.frame.Exclude(exclusion)
+	return ret
+}
+
+func (we sqlWindowExpression) withFrame(unit WindowFrameUnit, start, end WindowFrameBound) WindowExpression {
+	ret := we.clone()
+	frame := NewWindowFrame(unit, start, end)
+	if we.frame != nil {
+		frame = frame.Exclude(we.frame.Exclusion())
+	}
+	ret.frame = frame
+	return ret
+}
@@ -0,0 +1,149 @@
+package exp
+
+import "fmt"
+
+type (
+	WindowFrameUnit      int
+	WindowFrameBoundType int
+	WindowFrameExclusion int
+	// A bound of a window frame (e.g. UNBOUNDED PRECEDING, 1 PRECEDING, CURRENT ROW)
+	WindowFrameBound interface {
+		Type() WindowFrameBoundType
+		// The offset for PRECEDING and FOLLOWING bounds, nil for all other bounds
+		Offset() interface{}
+	}
+	// A window frame clause (e.g. ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE TIES)
+	WindowFrame interface {
+		Unit() WindowFrameUnit
+		Start() WindowFrameBound
+		End() WindowFrameBound
+		HasEnd() bool
+		Exclusion() WindowFrameExclusion
+		HasExclusion() bool
+		Exclude(exclusion WindowFrameExclusion) WindowFrame
+	}
+	windowFrameBound struct {
+		boundType WindowFrameBoundType
+		offset    interface{}
+	}
+	windowFrame struct {
+		unit      WindowFrameUnit
+		start     WindowFrameBound
+		end       WindowFrameBound
+		exclusion WindowFrameExclusion
+	}
+)
+
+const (
+	RowsFrameUnit WindowFrameUnit = iota
+	RangeFrameUnit
+	GroupsFrameUnit
+)
+
+const (
+	UnboundedPrecedingFrameBound WindowFrameBoundType = iota
+	PrecedingFrameBound
+	CurrentRowFrameBound
+	FollowingFrameBound
+	UnboundedFollowingFrameBound
+)
+
+const (
+	NoFrameExclusion WindowFrameExclusion = iota
+	ExcludeCurrentRowFrameExclusion
+	ExcludeGroupFrameExclusion
+	ExcludeTiesFrameExclusion
+	ExcludeNoOthersFrameExclusion
+)
+
+func NewWindowFrameBound(boundType WindowFrameBoundType, offset interface{}) WindowFrameBound {
+	return windowFrameBound{boundType: boundType, offset: offset}
+}
+
+func (wfb windowFrameBound) Type() WindowFrameBoundType {
+	return wfb.boundType
+}
+
+func (wfb windowFrameBound) Offset() interface{} {
+	return wfb.offset
+}
+
+// Creates a new window frame. The end bound may be nil in which case only the start bound is used
+// (e.g. ROWS UNBOUNDED PRECEDING)
+func NewWindowFrame(unit WindowFrameUnit, start, end WindowFrameBound) WindowFrame {
+	return windowFrame{unit: unit, start: start, end: end, exclusion: NoFrameExclusion}
+}
+
+func (wf windowFrame) Unit() WindowFrameUnit {
+	return wf.unit
+}
+
+func (wf windowFrame) Start() WindowFrameBound {
+	return wf.start
+}
+
+func (wf windowFrame) End() WindowFrameBound {
+	return wf.end
+}
+
+func (wf windowFrame) HasEnd() bool {
+	return wf.end != nil
+}
+
+func (wf windowFrame) Exclusion() WindowFrameExclusion {
+	return wf.exclusion
+}
+
+func (wf windowFrame) HasExclusion() bool {
+	return wf.exclusion != NoFrameExclusion
+}
+
+func (wf windowFrame) Exclude(exclusion WindowFrameExclusion) WindowFrame {
+	ret := wf
+	ret.exclusion = exclusion
+	return ret
+}
+
+func (wfu WindowFrameUnit) String() string {
+	switch wfu {
+	case RowsFrameUnit:
+		return "ROWS"
+	case RangeFrameUnit:
+		return "RANGE"
+	case GroupsFrameUnit:
+		return "GROUPS"
+	}
+	return fmt.Sprintf("%d", wfu)
+}
+
+func (wfb WindowFrameBoundType) String() string {
+	switch wfb {
+	case UnboundedPrecedingFrameBound:
+		return "UNBOUNDED PRECEDING"
+	case PrecedingFrameBound:
+		return "PRECEDING"
+	case CurrentRowFrameBound:
+		return "CURRENT ROW"
+	case FollowingFrameBound:
+		return "FOLLOWING"
+	case UnboundedFollowingFrameBound:
+		return "UNBOUNDED FOLLOWING"
+	}
+	return fmt.Sprintf("%d", wfb)
+}
+
+func (wfe WindowFrameExclusion) String() string {
+	switch wfe {
+	case NoFrameExclusion:
+		return "NONE"
+	case ExcludeCurrentRowFrameExclusion:
+		return "EXCLUDE CURRENT ROW"
+	case ExcludeGroupFrameExclusion:
+		return "EXCLUDE GROUP"
+	case ExcludeTiesFrameExclusion:
+		return "EXCLUDE TIES"
+	case ExcludeNoOthersFrameExclusion:
+		return "EXCLUDE NO OTHERS"
+	}
+	return fmt.Sprintf("%d", wfe)
+}
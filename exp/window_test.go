@@ -82,3 +82,53 @@ func (wet *windowExpressionTest) TestInherit() {
 	w = w.Inherit("w2")
 	wet.Equal(exp.NewIdentifierExpression("", "", "w2"), w.Parent())
 }
+
+func (wet *windowExpressionTest) TestFrame() {
+	w := exp.NewWindowExpression(exp.NewIdentifierExpression("", "", "w"), nil, nil, nil)
+	wet.False(w.HasFrame())
+	wet.Nil(w.Frame())
+
+	start := exp.NewWindowFrameBound(exp.UnboundedPrecedingFrameBound, nil)
+	end := exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil)
+
+	rows := w.Rows(start, end)
+	wet.False(w.HasFrame())
+	wet.True(rows.HasFrame())
+	wet.Equal(exp.NewWindowFrame(exp.RowsFrameUnit, start, end), rows.Frame())
+	wet.Equal(rows.Frame(), rows.Clone().(exp.WindowExpression).Frame())
+
+	wet.Equal(exp.NewWindowFrame(exp.RangeFrameUnit, start, end), w.Range(start, end).Frame())
+	wet.Equal(exp.NewWindowFrame(exp.GroupsFrameUnit, start, nil), w.Groups(start, nil).Frame())
+}
+
+func (wet *windowExpressionTest) TestExclude() {
+	w := exp.NewWindowExpression(exp.NewIdentifierExpression("", "", "w"), nil, nil, nil)
+	start := exp.NewWindowFrameBound(exp.PrecedingFrameBound, 1)
+	end := exp.NewWindowFrameBound(exp.FollowingFrameBound, 1)
+
+	frame := w.Rows(start, end).Exclude(exp.ExcludeTiesFrameExclusion).Frame()
+	wet.True(frame.HasExclusion())
+	wet.Equal(exp.ExcludeTiesFrameExclusion, frame.Exclusion())
+	wet.Equal(start, frame.Start())
+	wet.Equal(end, frame.End())
+
+	// the exclusion is kept when the frame is changed
+	frame = w.Exclude(exp.ExcludeGroupFrameExclusion).Range(start, nil).Frame()
+	wet.Equal(exp.RangeFrameUnit, frame.Unit())
+	wet.Equal(exp.ExcludeGroupFrameExclusion, frame.Exclusion())
+	wet.False(frame.HasEnd())
+}
+
+func (wet *windowExpressionTest) TestFrameEnumZeroValues() {
+	var (
+		unit      exp.WindowFrameUnit
+		boundType exp.WindowFrameBoundType
+		exclusion exp.WindowFrameExclusion
+	)
+	wet.Equal(exp.RowsFrameUnit, unit)
+	wet.Equal(exp.UnboundedPrecedingFrameBound, boundType)
+	wet.Equal(exp.NoFrameExclusion, exclusion)
+
+	start := exp.NewWindowFrameBound(exp.UnboundedPrecedingFrameBound, nil)
+	wet.False(exp.NewWindowFrame(exp.RowsFrameUnit, start, nil).HasExclusion())
+}
@@ -22,6 +22,11 @@ const (
 	Wait       = exp.Wait
 	NoWait     = exp.NoWait
 	SkipLocked = exp.SkipLocked
+
+	ExcludeCurrentRow = exp.ExcludeCurrentRowFrameExclusion
+	ExcludeGroup      = exp.ExcludeGroupFrameExclusion
+	ExcludeTies       = exp.ExcludeTiesFrameExclusion
+	ExcludeNoOthers   = exp.ExcludeNoOthersFrameExclusion
 )
 
 // Creates a new Casted expression
@@ -31,6 +36,34 @@ func Cast(e exp.Expression, t string) exp.CastExpression {
 	return exp.NewCastExpression(e, t)
 }
 
+// Creates an UPDATE action to be passed to MergeDataset#WhenMatched
+//
+//	WhenMatched(MergeUpdate(Record{"a": I("s.a")})) -> WHEN MATCHED THEN UPDATE SET "a"="s"."a"
+func MergeUpdate(update interface{}) exp.MergeAction {
+	return exp.NewMergeAction(exp.UpdateMergeAction, update)
+}
+
+// Creates a DELETE action to be passed to MergeDataset#WhenMatched
+//
+//	WhenMatched(MergeDelete()) -> WHEN MATCHED THEN DELETE
+func MergeDelete() exp.MergeAction {
+	return exp.NewMergeAction(exp.DeleteMergeAction, nil)
+}
+
+// Creates an INSERT action to be passed to MergeDataset#WhenNotMatched. The row may be a Record, map or struct
+//
+//	WhenNotMatched(MergeInsert(Record{"a": I("s.a")})) -> WHEN NOT MATCHED THEN INSERT ("a") VALUES ("s"."a")
+func MergeInsert(row interface{}) exp.MergeAction {
+	return exp.NewMergeAction(exp.InsertMergeAction, row)
+}
+
+// Creates a DO NOTHING action to be passed to MergeDataset#WhenMatched or MergeDataset#WhenNotMatched
+//
+//	WhenNotMatched(MergeDoNothing()) -> WHEN NOT MATCHED THEN DO NOTHING
+func MergeDoNothing() exp.MergeAction {
+	return exp.NewMergeAction(exp.DoNothingMergeAction, nil)
+}
+
 // Creates a conflict struct to be passed to InsertConflict to ignore constraint errors
 //
 //	InsertConflict(DoNothing(),...) -> INSERT INTO ... ON CONFLICT DO NOTHING
@@ -49,6 +82,42 @@ func DoUpdate(target string, update interface{}) exp.ConflictUpdateExpression {
 	return exp.NewDoUpdateConflictExpression(target, update)
 }
 
+// Creates a ConflictUpdate struct with a structured conflict target to be passed to InsertConflict. Unlike DoUpdate
+// the target is quoted for you.
+//
+//	InsertConflict(DoUpdateOn(ConflictColumns("a", "b"), update),...) ->
+//		INSERT INTO ... ON CONFLICT ("a", "b") DO UPDATE SET a=b
+//	InsertConflict(DoUpdateOn(ConflictConstraint("a_key"), update),...) ->
+//		INSERT INTO ... ON CONFLICT ON CONSTRAINT "a_key" DO UPDATE SET a=b
+func DoUpdateOn(target exp.ConflictTarget, update interface{}) exp.ConflictUpdateExpression {
+	return exp.NewDoUpdateConflictTargetExpression(target, update)
+}
+
+// Creates a reference to a column of the row proposed for insertion to be used in an ON CONFLICT DO UPDATE clause. The
+// SQL generated depends on the dialect.
+//
+//	DoUpdateOn(ConflictColumns("id"), Record{"name": Excluded("name")}) ->
+//		ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name" (postgres, sqlite3)
+//		ON DUPLICATE KEY UPDATE `name`=VALUES(`name`) (mysql)
+func Excluded(col string) exp.ExcludedExpression {
+	return exp.NewExcludedExpression(col)
+}
+
+// Creates a conflict target for a list of columns to be passed to DoUpdateOn. Use Where to target a partial index.
+//
+//	ConflictColumns("a", "b") -> ON CONFLICT ("a", "b")
+//	ConflictColumns("a").Where(C("deleted_at").IsNull()) -> ON CONFLICT ("a") WHERE ("deleted_at" IS NULL)
+func ConflictColumns(cols ...interface{}) exp.ConflictTarget {
+	return exp.NewConflictColumnsTarget(cols...)
+}
+
+// Creates a conflict target for a named constraint to be passed to DoUpdateOn
+//
+//	ConflictConstraint("a_key") -> ON CONFLICT ON CONSTRAINT "a_key"
+func ConflictConstraint(name string) exp.ConflictTarget {
+	return exp.NewConflictConstraintTarget(name)
+}
+
 // A list of expressions that should be ORed together
 //
 //	Or(I("a").Eq(10), I("b").Eq(11)) //(("a" = 10) OR ("b" = 11))
@@ -133,6 +202,47 @@ func COALESCE(vals ...interface{}) exp.SQLFunctionExpression {
 	return Func("COALESCE", vals...)
 }
 
+// Creates a new GROUPING sql function
+//
+//	GROUPING("a") -> GROUPING("a")
+//	GROUPING("a", I("b")) -> GROUPING("a", "b")
+func GROUPING(cols ...interface{}) exp.SQLFunctionExpression {
+	args := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		if s, ok := col.(string); ok {
+			col = I(s)
+		}
+		args = append(args, col)
+	}
+	return Func("GROUPING", args...)
+}
+
+// Creates a new ROLLUP grouping set to be used in a GROUP BY. A []string or []interface{} can be used to group
+// multiple columns together
+//
+//	Rollup("a", "b") -> ROLLUP("a", "b")
+//	Rollup("a", []string{"b", "c"}) -> ROLLUP("a", ("b", "c"))
+func Rollup(elements ...interface{}) exp.GroupingSetExpression {
+	return exp.NewGroupingSetExpression(exp.RollupGroupingSetType, elements...)
+}
+
+// Creates a new CUBE grouping set to be used in a GROUP BY. A []string or []interface{} can be used to group
+// multiple columns together
+//
+//	Cube("a", "b") -> CUBE("a", "b")
+//	Cube("a", []string{"b", "c"}) -> CUBE("a", ("b", "c"))
+func Cube(elements ...interface{}) exp.GroupingSetExpression {
+	return exp.NewGroupingSetExpression(exp.CubeGroupingSetType, elements...)
+}
+
+// Creates a new GROUPING SETS expression to be used in a GROUP BY. Each element is a set of columns, use an empty
+// []string for the empty grouping set
+//
+//	GroupingSets([]string{"a", "b"}, "a", []string{}) -> GROUPING SETS(("a", "b"), "a", ())
+func GroupingSets(sets ...interface{}) exp.GroupingSetExpression {
+	return exp.NewGroupingSetExpression(exp.GroupingSetsGroupingSetType, sets...)
+}
+
 //nolint:stylecheck,revive // sql function name
 func ROW_NUMBER() exp.SQLFunctionExpression {
 	return Func("ROW_NUMBER")
@@ -246,6 +356,7 @@ func T(table string) exp.IdentifierExpression {
 //	W("w").PartitionBy("a") -> "w" AS (PARTITION BY "a")
 //	W("w", "w1").PartitionBy("a") -> "w" AS ("w1" PARTITION BY "a")
 //	W("w", "w1").PartitionBy("a").OrderBy("b") -> "w" AS ("w1" PARTITION BY "a" ORDER BY "b")
+//	W().OrderBy("a").Rows(UnboundedPreceding(), CurrentRow()) -> (ORDER BY "a" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)
 func W(ws ...string) exp.WindowExpression {
 	switch len(ws) {
 	case 0:
@@ -257,6 +368,41 @@ func W(ws ...string) exp.WindowExpression {
 	}
 }
 
+// Creates an UNBOUNDED PRECEDING window frame bound
+//
+//	W().OrderBy("a").Rows(UnboundedPreceding(), nil) -> (ORDER BY "a" ROWS UNBOUNDED PRECEDING)
+func UnboundedPreceding() exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.UnboundedPrecedingFrameBound, nil)
+}
+
+// Creates an offset PRECEDING window frame bound
+//
+//	W().OrderBy("a").Rows(Preceding(2), CurrentRow()) -> (ORDER BY "a" ROWS BETWEEN 2 PRECEDING AND CURRENT ROW)
+func Preceding(offset interface{}) exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.PrecedingFrameBound, offset)
+}
+
+// Creates a CURRENT ROW window frame bound
+//
+//	W().OrderBy("a").Range(CurrentRow(), UnboundedFollowing()) -> (ORDER BY "a" RANGE BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)
+func CurrentRow() exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil)
+}
+
+// Creates an offset FOLLOWING window frame bound
+//
+//	W().OrderBy("a").Rows(Preceding(1), Following(1)) -> (ORDER BY "a" ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING)
+func Following(offset interface{}) exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.FollowingFrameBound, offset)
+}
+
+// Creates an UNBOUNDED FOLLOWING window frame bound
+//
+//	W().OrderBy("a").Rows(CurrentRow(), UnboundedFollowing()) -> (ORDER BY "a" ROWS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)
+func UnboundedFollowing() exp.WindowFrameBound {
+	return exp.NewWindowFrameBound(exp.UnboundedFollowingFrameBound, nil)
+}
+
 // Creates a new ON clause to be used within a join
 //
 //	ds.Join(goqu.T("my_table"), goqu.On(
@@ -318,14 +464,41 @@ func Lateral(table exp.AppendableExpression) exp.LateralExpression {
 	return exp.NewLateralExpression(table)
 }
 
-// Create a new ANY comparison
+// Create a new ANY comparison. A slice is generated as an array (e.g. ANY (ARRAY[1, 2])) or as a single array
+// parameter when prepared and the dialect supports it (e.g. ANY ($1))
 func Any(val interface{}) exp.SQLFunctionExpression {
-	return Func("ANY ", val)
+	return Func("ANY ", arrayValue(val))
 }
 
-// Create a new ALL comparison
+// Create a new ALL comparison. A slice is generated as an array (e.g. ALL (ARRAY[1, 2])) or as a single array
+// parameter when prepared and the dialect supports it (e.g. ALL ($1))
 func All(val interface{}) exp.SQLFunctionExpression {
-	return Func("ALL ", val)
+	return Func("ALL ", arrayValue(val))
+}
+
+// Marks a value as sensitive. The value is used in the statement as is but is replaced with [REDACTED] when the
+// statement is logged, traced or included in an error. Struct fields can also be marked as sensitive using the
+// goqu:"sensitive" tag.
+//
+//	I("password").Eq(Sensitive("secret")) -> ("password" = 'secret'), logged as ("password" = [REDACTED])
+func Sensitive(val interface{}) exp.SensitiveExpression {
+	return exp.NewSensitiveExpression(val)
+}
+
+// Creates a new array from a slice. The array is generated as ARRAY[1, 2] or as a single array parameter when prepared
+// and the dialect supports it.
+//
+//	I("tags").Eq(Array([]string{"a", "b"})) -> ("tags" = ARRAY['a', 'b'])
+func Array(elements interface{}) exp.ArrayValueExpression {
+	return exp.NewArrayValueExpression(elements)
+}
+
+// used internally to wrap slices passed to ANY and ALL in an ArrayValueExpression
+func arrayValue(val interface{}) interface{} {
+	if exp.IsArrayValue(val) {
+		return exp.NewArrayValueExpression(val)
+	}
+	return val
 }
 
 func Case() exp.CaseExpression {
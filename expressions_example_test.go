@@ -438,6 +438,37 @@ func ExampleCOUNT_havingClause() {
 	// SELECT COUNT("a") AS "COUNT" FROM "test" GROUP BY "a" HAVING (COUNT("a") > ?) [10]
 }
 
+func ExampleCOUNT_filter() {
+	ds := goqu.From("test").Select(
+		goqu.COUNT("*").Filter(goqu.C("status").Eq("active")).As("active"),
+		goqu.COUNT("*").As("total"),
+	)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT COUNT(*) FILTER (WHERE ("status" = 'active')) AS "active", COUNT(*) AS "total" FROM "test" []
+	// SELECT COUNT(*) FILTER (WHERE ("status" = ?)) AS "active", COUNT(*) AS "total" FROM "test" [active]
+}
+
+func ExampleFunc_withinGroup() {
+	ds := goqu.From("test").Select(
+		goqu.Func("percentile_cont", 0.5).WithinGroup(goqu.C("a").Asc()),
+	)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT percentile_cont(0.5) WITHIN GROUP (ORDER BY "a" ASC) FROM "test" []
+	// SELECT percentile_cont(?) WITHIN GROUP (ORDER BY "a" ASC) FROM "test" [0.5]
+}
+
 func ExampleCast() {
 	sql, _, _ := goqu.From("test").
 		Select(goqu.Cast(goqu.C("json1"), "TEXT").As("json_text")).
@@ -622,6 +653,31 @@ func ExampleI() {
 	// SELECT "test".* FROM "test" []
 }
 
+func ExampleI_json() {
+	doc := goqu.I("doc").JSON()
+	ds := goqu.From("test").
+		Select(doc.Get("a"), doc.GetText("b").As("b"), doc.Path("c", 0)).
+		Where(
+			doc.HasKey("a"),
+			doc.Contains(map[string]interface{}{"d": true}),
+			doc.GetText("e").Eq("f"),
+		)
+
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.WithDialect("mysql").ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// SELECT "doc"->'a', "doc"->>'b' AS "b", "doc"#>'{c,0}' FROM "test" WHERE (("doc" ? 'a') AND ("doc" @> '{"d":true}') AND ("doc"->>'e' = 'f')) []
+	// SELECT "doc"->?, "doc"->>? AS "b", "doc"#>? FROM "test" WHERE (("doc" ? ?) AND ("doc" @> ?) AND ("doc"->>? = ?)) [a b {c,0} a {"d":true} e f]
+	// SELECT JSON_EXTRACT(`doc`, '$.a'), JSON_UNQUOTE(JSON_EXTRACT(`doc`, '$.b')) AS `b`, JSON_EXTRACT(`doc`, '$.c[0]') FROM `test` WHERE ((JSON_EXTRACT(`doc`, '$.a') IS NOT NULL) AND JSON_CONTAINS(`doc`, '{\"d\":true}') AND (JSON_UNQUOTE(JSON_EXTRACT(`doc`, '$.e')) = 'f')) []
+}
+
 func ExampleL() {
 	ds := goqu.From("test").Where(
 		// literal with no args
@@ -1737,6 +1793,36 @@ func ExampleW() {
 	// SELECT ROW_NUMBER() OVER ("w" ORDER BY "b") FROM "test" WINDOW "w" AS (PARTITION BY "a") []
 }
 
+func ExampleW_frame() {
+	ds := goqu.From("test").
+		Select(goqu.SUM("a").Over(goqu.W().OrderBy("b").Rows(goqu.UnboundedPreceding(), goqu.CurrentRow())))
+	query, args, _ := ds.ToSQL()
+	fmt.Println(query, args)
+
+	ds = goqu.From("test").
+		Select(goqu.SUM("a").Over(goqu.W().OrderBy("b").Range(goqu.Preceding(1), goqu.Following(1))))
+	query, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(query, args)
+
+	ds = goqu.From("test").
+		Select(goqu.SUM("a").Over(
+			goqu.W().OrderBy("b").Groups(goqu.CurrentRow(), goqu.UnboundedFollowing()).Exclude(goqu.ExcludeTies),
+		))
+	query, args, _ = ds.ToSQL()
+	fmt.Println(query, args)
+
+	ds = goqu.From("test").
+		Select(goqu.SUM("a").OverName(goqu.I("w"))).
+		Window(goqu.W("w").OrderBy("b").Rows(goqu.UnboundedPreceding(), nil))
+	query, args, _ = ds.ToSQL()
+	fmt.Println(query, args)
+	// Output:
+	// SELECT SUM("a") OVER (ORDER BY "b" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) FROM "test" []
+	// SELECT SUM("a") OVER (ORDER BY "b" RANGE BETWEEN ? PRECEDING AND ? FOLLOWING) FROM "test" [1 1]
+	// SELECT SUM("a") OVER (ORDER BY "b" GROUPS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING EXCLUDE TIES) FROM "test" []
+	// SELECT SUM("a") OVER "w" FROM "test" WINDOW "w" AS (ORDER BY "b" ROWS UNBOUNDED PRECEDING) []
+}
+
 func ExampleLateral() {
 	maxEntry := goqu.From("entry").
 		Select(goqu.MAX("int").As("max_int")).
@@ -1821,6 +1907,39 @@ func ExampleAll() {
 	// SELECT * FROM "test" WHERE ("id" = ALL ((SELECT "test_id" FROM "other"))) []
 }
 
+func ExampleAny_slice() {
+	ds := goqu.Dialect("postgres").From("test").Where(goqu.Ex{
+		"id": goqu.Any([]int64{1, 2, 3}),
+	})
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+	// Output:
+	// SELECT * FROM "test" WHERE ("id" = ANY (ARRAY[1, 2, 3])) []
+	// SELECT * FROM "test" WHERE ("id" = ANY ($1)) [{[1 2 3]}]
+}
+
+func ExampleArray() {
+	tags := goqu.C("tags").Array()
+	ds := goqu.Dialect("postgres").From("test").
+		Select(tags.Concat(goqu.Array([]string{"new"})).As("tags")).
+		Where(
+			tags.Contains([]string{"a"}),
+			tags.ContainedBy([]string{"a", "b", "c"}),
+			tags.Overlaps(goqu.From("other").Select("tags")),
+		)
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	sql, args, _ = ds.Prepared(true).ToSQL()
+	fmt.Println(sql, args)
+	// Output:
+	// SELECT ("tags" || ARRAY['new']) AS "tags" FROM "test" WHERE (("tags" @> ARRAY['a']) AND ("tags" <@ ARRAY['a', 'b', 'c']) AND ("tags" && (SELECT "tags" FROM "other"))) []
+	// SELECT ("tags" || $1) AS "tags" FROM "test" WHERE (("tags" @> $2) AND ("tags" <@ $3) AND ("tags" && (SELECT "tags" FROM "other"))) [{[new]} {[a]} {[a b c]}]
+}
+
 func ExampleCase_search() {
 	ds := goqu.From("test").
 		Select(
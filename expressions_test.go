@@ -26,6 +26,41 @@ func (ges *goquExpressionsSuite) TestDoUpdate() {
 	ges.Equal(exp.NewDoUpdateConflictExpression("test", goqu.Record{"a": "b"}), goqu.DoUpdate("test", goqu.Record{"a": "b"}))
 }
 
+func (ges *goquExpressionsSuite) TestDoUpdateOn() {
+	ges.Equal(
+		exp.NewDoUpdateConflictTargetExpression(exp.NewConflictColumnsTarget("a"), goqu.Record{"a": "b"}),
+		goqu.DoUpdateOn(goqu.ConflictColumns("a"), goqu.Record{"a": "b"}),
+	)
+}
+
+func (ges *goquExpressionsSuite) TestExcluded() {
+	ges.Equal(exp.NewExcludedExpression("a"), goqu.Excluded("a"))
+}
+
+func (ges *goquExpressionsSuite) TestConflictColumns() {
+	ges.Equal(exp.NewConflictColumnsTarget("a", "b"), goqu.ConflictColumns("a", "b"))
+}
+
+func (ges *goquExpressionsSuite) TestConflictConstraint() {
+	ges.Equal(exp.NewConflictConstraintTarget("a_key"), goqu.ConflictConstraint("a_key"))
+}
+
+func (ges *goquExpressionsSuite) TestMergeUpdate() {
+	ges.Equal(exp.NewMergeAction(exp.UpdateMergeAction, goqu.Record{"a": "b"}), goqu.MergeUpdate(goqu.Record{"a": "b"}))
+}
+
+func (ges *goquExpressionsSuite) TestMergeDelete() {
+	ges.Equal(exp.NewMergeAction(exp.DeleteMergeAction, nil), goqu.MergeDelete())
+}
+
+func (ges *goquExpressionsSuite) TestMergeInsert() {
+	ges.Equal(exp.NewMergeAction(exp.InsertMergeAction, goqu.Record{"a": "b"}), goqu.MergeInsert(goqu.Record{"a": "b"}))
+}
+
+func (ges *goquExpressionsSuite) TestMergeDoNothing() {
+	ges.Equal(exp.NewMergeAction(exp.DoNothingMergeAction, nil), goqu.MergeDoNothing())
+}
+
 func (ges *goquExpressionsSuite) TestOr() {
 	e1 := goqu.C("a").Eq("b")
 	e2 := goqu.C("b").Eq(2)
@@ -74,6 +109,26 @@ func (ges *goquExpressionsSuite) TestSUM() {
 	ges.Equal(exp.NewSQLFunctionExpression("SUM", goqu.I("col")), goqu.SUM("col"))
 }
 
+func (ges *goquExpressionsSuite) TestGROUPING() {
+	ges.Equal(exp.NewSQLFunctionExpression("GROUPING", goqu.I("a")), goqu.GROUPING("a"))
+	ges.Equal(exp.NewSQLFunctionExpression("GROUPING", goqu.I("a"), goqu.I("b")), goqu.GROUPING("a", goqu.I("b")))
+}
+
+func (ges *goquExpressionsSuite) TestRollup() {
+	ges.Equal(exp.NewGroupingSetExpression(exp.RollupGroupingSetType, "a", "b"), goqu.Rollup("a", "b"))
+}
+
+func (ges *goquExpressionsSuite) TestCube() {
+	ges.Equal(exp.NewGroupingSetExpression(exp.CubeGroupingSetType, "a", "b"), goqu.Cube("a", "b"))
+}
+
+func (ges *goquExpressionsSuite) TestGroupingSets() {
+	ges.Equal(
+		exp.NewGroupingSetExpression(exp.GroupingSetsGroupingSetType, []string{"a", "b"}, []string{}),
+		goqu.GroupingSets([]string{"a", "b"}, []string{}),
+	)
+}
+
 func (ges *goquExpressionsSuite) TestCOALESCE() {
 	ges.Equal(exp.NewSQLFunctionExpression("COALESCE", goqu.I("col"), nil), goqu.COALESCE(goqu.I("col"), nil))
 }
@@ -138,6 +193,14 @@ func (ges *goquExpressionsSuite) TestW() {
 	ges.Equal(exp.NewWindowExpression(goqu.I("a"), goqu.I("b"), nil, nil), goqu.W("a", "b", "c"))
 }
 
+func (ges *goquExpressionsSuite) TestWindowFrameBounds() {
+	ges.Equal(exp.NewWindowFrameBound(exp.UnboundedPrecedingFrameBound, nil), goqu.UnboundedPreceding())
+	ges.Equal(exp.NewWindowFrameBound(exp.PrecedingFrameBound, 1), goqu.Preceding(1))
+	ges.Equal(exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil), goqu.CurrentRow())
+	ges.Equal(exp.NewWindowFrameBound(exp.FollowingFrameBound, 1), goqu.Following(1))
+	ges.Equal(exp.NewWindowFrameBound(exp.UnboundedFollowingFrameBound, nil), goqu.UnboundedFollowing())
+}
+
 func (ges *goquExpressionsSuite) TestOn() {
 	ges.Equal(exp.NewJoinOnCondition(goqu.Ex{"a": "b"}), goqu.On(goqu.Ex{"a": "b"}))
 }
@@ -178,11 +241,21 @@ func (ges *goquExpressionsSuite) TestLateral() {
 func (ges *goquExpressionsSuite) TestAny() {
 	ds := goqu.From("test").Select("id")
 	ges.Equal(exp.NewSQLFunctionExpression("ANY ", ds), goqu.Any(ds))
+
+	ids := []int64{1, 2}
+	ges.Equal(exp.NewSQLFunctionExpression("ANY ", exp.NewArrayValueExpression(ids)), goqu.Any(ids))
 }
 
 func (ges *goquExpressionsSuite) TestAll() {
 	ds := goqu.From("test").Select("id")
 	ges.Equal(exp.NewSQLFunctionExpression("ALL ", ds), goqu.All(ds))
+
+	ids := []int64{1, 2}
+	ges.Equal(exp.NewSQLFunctionExpression("ALL ", exp.NewArrayValueExpression(ids)), goqu.All(ids))
+}
+
+func (ges *goquExpressionsSuite) TestArray() {
+	ges.Equal(exp.NewArrayValueExpression([]string{"a", "b"}), goqu.Array([]string{"a", "b"}))
 }
 
 func TestGoquExpressions(t *testing.T) {
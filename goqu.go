@@ -14,6 +14,8 @@ package goqu
 import (
 	"time"
 
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/doug-martin/goqu/v9/internal/util"
 	"github.com/doug-martin/goqu/v9/sqlgen"
 )
@@ -22,6 +24,21 @@ type DialectWrapper struct {
 	dialect string
 }
 
+// The behavior when the columns returned by a query do not match the fields of the struct being scanned into. See
+// SetDefaultScanMode and SelectDataset#ScanMode.
+type ScanMode = exec.ScanMode
+
+const (
+	// Uses the mode set with SetDefaultScanMode
+	ScanModeDefault = exec.ScanModeDefault
+	// Returns an error if a column does not have a corresponding struct field (the default)
+	ScanModeMatchColumns = exec.ScanModeMatchColumns
+	// Ignores columns that do not have a corresponding struct field
+	ScanModeIgnoreUnknownColumns = exec.ScanModeIgnoreUnknownColumns
+	// Returns an error if a column does not have a corresponding struct field or a struct field is not populated
+	ScanModeStrict = exec.ScanModeStrict
+)
+
 // Creates a new DialectWrapper to create goqu.Datasets or goqu.Databases with the specified dialect.
 func Dialect(dialect string) DialectWrapper {
 	return DialectWrapper{dialect: dialect}
@@ -57,6 +74,11 @@ func (dw DialectWrapper) Truncate(table ...interface{}) *TruncateDataset {
 	return Truncate(table...).WithDialect(dw.dialect)
 }
 
+// Create a new dataset for creating MERGE sql statements
+func (dw DialectWrapper) Merge(target interface{}) *MergeDataset {
+	return Merge(target).WithDialect(dw.dialect)
+}
+
 func (dw DialectWrapper) DB(db SQLDatabase) *Database {
 	return newDatabase(dw.dialect, db)
 }
@@ -78,6 +100,25 @@ func SetColumnRenameFunction(renameFunc func(string) string) {
 	util.SetColumnRenameFunction(renameFunc)
 }
 
+// Set the behavior when the columns returned by a query do not match the fields of the struct being scanned into.
+// By default this is ScanModeMatchColumns, which returns an error if a column does not have a corresponding struct
+// field. Use SelectDataset#ScanMode to override the mode for a single dataset.
+func SetDefaultScanMode(mode ScanMode) {
+	exec.SetDefaultScanMode(mode)
+}
+
+// Registers a matcher used to determine if a value is sensitive. Values that match are replaced with [REDACTED] when a
+// statement is logged, traced or included in an error. Matchers should be registered before any statements are
+// generated. See Sensitive.
+//
+//	goqu.RegisterSensitiveValueMatcher(func(val interface{}) bool {
+//	    s, ok := val.(string)
+//	    return ok && strings.HasPrefix(s, "sk_live_")
+//	})
+func RegisterSensitiveValueMatcher(matcher exp.SensitiveValueMatcher) {
+	exp.RegisterSensitiveValueMatcher(matcher)
+}
+
 // Set the location to use when interpolating time.Time instances. See https://golang.org/pkg/time/#LoadLocation
 // NOTE: This has no effect when using prepared statements.
 func SetTimeLocation(loc *time.Location) {
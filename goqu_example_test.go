@@ -100,7 +100,7 @@ func ExampleDialect_datasetPostgres() {
 
 	// Output:
 	// SELECT * FROM "test" WHERE (("baz" IN (1, 2, 3)) AND ("foo" = 'bar')) LIMIT 10 []
-	// SELECT * FROM "test" WHERE (("baz" IN ($1, $2, $3)) AND ("foo" = $4)) LIMIT $5 [1 2 3 bar 10]
+	// SELECT * FROM "test" WHERE (("baz" = ANY($1)) AND ("foo" = $2)) LIMIT $3 [{[1 2 3]} bar 10]
 }
 
 // Creating a postgres dataset. Be sure to import the postgres adapter
@@ -1,7 +1,10 @@
 package goqu
 
 import (
+	"context"
 	"fmt"
+	"math"
+	"reflect"
 
 	"github.com/doug-martin/goqu/v9/exec"
 	"github.com/doug-martin/goqu/v9/exp"
@@ -14,10 +17,22 @@ type InsertDataset struct {
 	clauses      exp.InsertClauses
 	isPrepared   prepared
 	queryFactory exec.QueryFactory
+	txRunner     txRunner
+	batchSize    int
+	batchInTx    bool
 	err          error
 }
 
-var ErrUnsupportedIntoType = errors.New("unsupported table type, a string or identifier expression is required")
+var (
+	ErrUnsupportedIntoType = errors.New("unsupported table type, a string or identifier expression is required")
+	ErrBatchTxNotSupported = errors.New(
+		"unable to execute batches in a transaction, the dataset must be created with Database#Insert or TxDatabase#Insert",
+	)
+	ErrCopyFromTxNotSupported = errors.New(
+		"unable to execute COPY FROM, the dataset must be created with Database#Insert or TxDatabase#Insert",
+	)
+	ErrNoRowsForCopyFrom = errors.New("no rows found for COPY FROM, use Rows or Cols and Vals to add rows")
+)
 
 // used internally by database to create a database with a specific adapter
 func newInsertDataset(d string, queryFactory exec.QueryFactory) *InsertDataset {
@@ -87,6 +102,9 @@ func (id *InsertDataset) copy(clauses exp.InsertClauses) *InsertDataset {
 		clauses:      clauses,
 		isPrepared:   id.isPrepared,
 		queryFactory: id.queryFactory,
+		txRunner:     id.txRunner,
+		batchSize:    id.batchSize,
+		batchInTx:    id.batchInTx,
 		err:          id.err,
 	}
 }
@@ -190,6 +208,21 @@ func (id *InsertDataset) OnConflict(conflict exp.ConflictExpression) *InsertData
 	return id.copy(id.clauses.SetOnConflict(conflict))
 }
 
+// Adds an upsert (ON CONFLICT DO UPDATE/ON DUPLICATE KEY UPDATE) clause to the dataset. The update is derived from
+// the inserted columns, each column is set to the value proposed for insertion (e.g. EXCLUDED."name" or
+// VALUES(`name`) depending on the dialect). The conflict columns and struct fields tagged with goqu:"skipupdate" are not
+// updated. See examples.
+//
+//	Insert("items").Rows(Record{"id": 1, "name": "a"}).Upsert("id") ->
+//		INSERT INTO "items" ("id", "name") VALUES (1, 'a') ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name"
+func (id *InsertDataset) Upsert(conflictCols ...string) *InsertDataset {
+	cols := make([]interface{}, 0, len(conflictCols))
+	for _, col := range conflictCols {
+		cols = append(cols, col)
+	}
+	return id.OnConflict(exp.NewDoUpdateFromInsertConflictExpression(exp.NewConflictColumnsTarget(cols...)))
+}
+
 // Clears the on conflict clause. See example
 func (id *InsertDataset) ClearOnConflict() *InsertDataset {
 	return id.OnConflict(nil)
@@ -260,7 +293,408 @@ func (id *InsertDataset) ReturnsColumns() bool {
 //
 //	db.Insert("test").Rows(Record{"name":"Bob"}).Executor().Exec()
 func (id *InsertDataset) Executor() exec.QueryExecutor {
-	return id.queryFactory.FromSQLBuilder(id.insertSQLBuilder())
+	return id.queryFactory.FromSQLBuilder(id.insertSQLBuilder()).WithOperation(exec.OperationInsert).
+		WithColumnConverter(id.dialect.DialectOptions().ConvertColumnValue)
+}
+
+// Sets the maximum number of rows to insert in each statement when using ExecBatches or Batches. A size of 0 (the
+// default) only splits the rows when a prepared statement would exceed the dialect's MaxParams or a statement would
+// exceed the dialect's MaxInsertRows. See examples.
+func (id *InsertDataset) Batch(size int) *InsertDataset {
+	ret := id.copy(id.clauses)
+	ret.batchSize = size
+	return ret
+}
+
+// Set to true to execute all batches inside of a single transaction when using ExecBatches. If any batch fails the
+// transaction is rolled back. The dataset must be created with Database#Insert or TxDatabase#Insert, datasets created
+// with TxDatabase#Insert execute inside of that transaction.
+func (id *InsertDataset) BatchInTx(inTx bool) *InsertDataset {
+	ret := id.copy(id.clauses)
+	ret.batchInTx = inTx
+	return ret
+}
+
+// Splits the rows of this dataset into multiple datasets. The number of rows in each dataset is limited by the size
+// set with Batch, by the dialect's MaxInsertRows, and when the dataset is prepared by the dialect's MaxParams so that
+// the params of the rows and the rest of the statement (e.g. ON CONFLICT DO UPDATE) do not exceed the limit. Datasets
+// that insert from a query or have no rows are returned as a single batch. See examples.
+func (id *InsertDataset) Batches() ([]*InsertDataset, error) {
+	if id.err != nil {
+		return nil, id.err
+	}
+	ic := id.clauses
+	switch {
+	case ic.HasRows():
+		rows := flattenInsertRows(ic.Rows())
+		ie, err := exp.NewInsertExpression(rows...)
+		if err != nil {
+			return nil, err
+		}
+		if ie.IsInsertFrom() {
+			return []*InsertDataset{id}, nil
+		}
+		size := id.batchRowLimit(ie.Cols(), func(n int) *InsertDataset {
+			return id.copy(ic.SetRows(repeatInsertRow(rows[0], n)))
+		})
+		batches := make([]*InsertDataset, 0, len(rows)/size+1)
+		for start := 0; start < len(rows); start += size {
+			end := start + size
+			if end > len(rows) {
+				end = len(rows)
+			}
+			batches = append(batches, id.copy(ic.SetRows(rows[start:end])))
+		}
+		return batches, nil
+	case ic.HasCols() && ic.HasVals():
+		vals := ic.Vals()
+		size := id.batchRowLimit(ic.Cols(), func(n int) *InsertDataset {
+			sample := make([][]interface{}, 0, n)
+			for i := 0; i < n; i++ {
+				sample = append(sample, vals[0])
+			}
+			return id.copy(ic.SetVals(sample))
+		})
+		batches := make([]*InsertDataset, 0, len(vals)/size+1)
+		for start := 0; start < len(vals); start += size {
+			end := start + size
+			if end > len(vals) {
+				end = len(vals)
+			}
+			batches = append(batches, id.copy(ic.SetVals(vals[start:end])))
+		}
+		return batches, nil
+	default:
+		return []*InsertDataset{id}, nil
+	}
+}
+
+// Executes the INSERT statement for each batch returned from Batches in order, and returns the total number of rows
+// affected. Execution stops at the first error. See Batch and BatchInTx.
+//
+//	affected, err := db.Insert("items").Rows(items).Prepared(true).Batch(500).ExecBatches(ctx)
+func (id *InsertDataset) ExecBatches(ctx context.Context) (int64, error) {
+	batches, err := id.Batches()
+	if err != nil {
+		return 0, err
+	}
+	if id.queryFactory == nil {
+		return 0, ErrQueryFactoryNotFoundError
+	}
+	if !id.batchInTx {
+		return execInsertBatches(ctx, id.queryFactory, batches)
+	}
+	if id.txRunner == nil {
+		return 0, ErrBatchTxNotSupported
+	}
+	var affected int64
+	err = id.txRunner.runInTx(ctx, func(tx *TxDatabase) (txErr error) {
+		affected, txErr = execInsertBatches(ctx, tx.queryFactory(), batches)
+		return txErr
+	})
+	return affected, err
+}
+
+// Bulk loads the rows of this dataset using the dialect's CopyInQuery (e.g. COPY FROM STDIN with postgres or INSERT BULK
+// with sqlserver) instead of an INSERT statement, and returns the number of rows copied. Rows are converted the same
+// way as an INSERT so struct fields tagged with skipinsert are skipped. COPY cannot use DEFAULT for a single value, so
+// the rows are grouped by their DEFAULT columns (e.g. an empty field tagged with defaultifempty) and each group is
+// copied without those columns, rows that are DEFAULT for every column are inserted with DEFAULT VALUES. The copy is
+// executed in a transaction, if the dataset was created with Database#Insert a new transaction is used.
+//
+//	copied, err := db.Insert("items").Rows(items).CopyFrom(ctx)
+//
+// Errors:
+//   - The dialect does not support COPY FROM
+//   - The INTO clause is not a table
+//   - There are no rows, or the rows contain expressions other than DEFAULT
+//   - There is an error preparing the statement or copying a row
+func (id *InsertDataset) CopyFrom(ctx context.Context) (int64, error) {
+	if id.err != nil {
+		return 0, id.err
+	}
+	copyInQuery := id.dialect.DialectOptions().CopyInQuery
+	if copyInQuery == nil {
+		return 0, errCopyFromNotSupported(id.dialect.Dialect())
+	}
+	schema, table, err := copyFromTable(id.clauses.Into())
+	if err != nil {
+		return 0, err
+	}
+	batches, err := id.copyFromRows()
+	if err != nil {
+		return 0, err
+	}
+	if id.txRunner == nil {
+		return 0, ErrCopyFromTxNotSupported
+	}
+	var copied int64
+	err = id.txRunner.runInTx(ctx, func(tx *TxDatabase) error {
+		for _, batch := range batches {
+			n, copyErr := id.copyBatch(ctx, tx, copyInQuery(schema, table, batch.cols), batch)
+			if copyErr != nil {
+				return copyErr
+			}
+			copied += n
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return copied, nil
+}
+
+// used internally to hold consecutive rows of a COPY FROM that have a DEFAULT value for the same columns
+type copyFromBatch struct {
+	key  string
+	cols []string
+	vals [][]interface{}
+}
+
+// used internally to get the columns and values to copy. COPY cannot use DEFAULT for a single value so consecutive rows
+// with the same columns that are not DEFAULT are grouped, and each group is copied with only those columns. A new group
+// is started whenever the DEFAULT columns change so the rows are copied in order.
+func (id *InsertDataset) copyFromRows() ([]*copyFromBatch, error) {
+	ic := id.clauses
+	var ie exp.InsertExpression
+	var err error
+	switch {
+	case ic.HasRows():
+		if ie, err = exp.NewInsertExpression(ic.Rows()...); err != nil {
+			return nil, err
+		}
+	case ic.HasCols() && ic.HasVals():
+		if ie, err = exp.NewInsertExpression(); err != nil {
+			return nil, err
+		}
+		ie = ie.SetCols(ic.Cols()).SetVals(ic.Vals())
+	}
+	if ie == nil || ie.IsInsertFrom() || len(ie.Vals()) == 0 {
+		return nil, ErrNoRowsForCopyFrom
+	}
+	names := make([]string, 0, len(ie.Cols().Columns()))
+	for _, col := range ie.Cols().Columns() {
+		name, ok := copyFromColumn(col)
+		if !ok {
+			return nil, errUnsupportedCopyFromColumn(col)
+		}
+		names = append(names, name)
+	}
+	var batches []*copyFromBatch
+	for _, row := range ie.Vals() {
+		if len(row) != len(names) {
+			return nil, errCopyFromRowLength(len(names), len(row))
+		}
+		key := make([]byte, 0, len(row))
+		cols := make([]string, 0, len(row))
+		copyRow := make([]interface{}, 0, len(row))
+		for i, val := range row {
+			if isDefaultValue(val) {
+				key = append(key, '0')
+				continue
+			}
+			if s, ok := val.(exp.SensitiveExpression); ok {
+				val = s.Value()
+			}
+			if _, ok := val.(exp.Expression); ok {
+				return nil, errUnsupportedCopyFromValue(names[i], val)
+			}
+			key = append(key, '1')
+			cols = append(cols, names[i])
+			copyRow = append(copyRow, val)
+		}
+		if len(batches) == 0 || batches[len(batches)-1].key != string(key) {
+			batches = append(batches, &copyFromBatch{key: string(key), cols: cols})
+		}
+		batch := batches[len(batches)-1]
+		batch.vals = append(batch.vals, copyRow)
+	}
+	return batches, nil
+}
+
+// used internally to copy a batch of rows in the transaction. Rows that are DEFAULT for every column cannot be copied
+// and are inserted with INSERT ... DEFAULT VALUES instead.
+func (id *InsertDataset) copyBatch(ctx context.Context, tx *TxDatabase, query string, batch *copyFromBatch) (int64, error) {
+	ctx = exec.ContextWithOperation(ctx, exec.OperationInsert)
+	if len(batch.cols) == 0 {
+		insertSQL, err := id.defaultValuesSQL()
+		if err != nil {
+			return 0, err
+		}
+		var inserted int64
+		for range batch.vals {
+			res, execErr := tx.ExecContext(ctx, insertSQL)
+			if execErr != nil {
+				return inserted, execErr
+			}
+			n, raErr := res.RowsAffected()
+			if raErr != nil {
+				return inserted, raErr
+			}
+			inserted += n
+		}
+		return inserted, nil
+	}
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = stmt.Close() }()
+	for _, row := range batch.vals {
+		if _, err = stmt.ExecContext(ctx, row...); err != nil {
+			return 0, err
+		}
+	}
+	res, err := stmt.ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// used internally to create the INSERT statement for a row that is DEFAULT for every column. Some dialects (e.g.
+// sqlserver) do not write DEFAULT VALUES for an INSERT without rows, so it is added when the dialect omits it.
+func (id *InsertDataset) defaultValuesSQL() (string, error) {
+	insertSQL, _, err := Insert(id.clauses.Into()).SetDialect(id.dialect).ToSQL()
+	if err != nil {
+		return "", err
+	}
+	if len(id.dialect.DialectOptions().DefaultValuesFragment) == 0 {
+		insertSQL += " DEFAULT VALUES"
+	}
+	return insertSQL, nil
+}
+
+// used internally to determine the max number of rows for each batch. When the dataset is prepared the params used by
+// the rest of the statement (e.g. ON CONFLICT DO UPDATE SET values and WHERE args) are subtracted from the dialect's
+// MaxParams, sample returns a copy of the dataset with the first row repeated n times and is used to count them.
+func (id *InsertDataset) batchRowLimit(cols exp.ColumnListExpression, sample func(n int) *InsertDataset) int {
+	size := id.batchSize
+	opts := id.dialect.DialectOptions()
+	if id.IsPrepared() && opts.MaxParams > 0 && cols != nil && !cols.IsEmpty() {
+		perRow, fixed := len(cols.Columns()), 0
+		_, oneRowArgs, oneErr := sample(1).ToSQL()
+		_, twoRowArgs, twoErr := sample(2).ToSQL()
+		if oneErr == nil && twoErr == nil {
+			if rowParams := len(twoRowArgs) - len(oneRowArgs); rowParams > perRow {
+				perRow = rowParams
+			}
+			fixed = len(oneRowArgs) - (len(twoRowArgs) - len(oneRowArgs))
+		}
+		maxRows := (opts.MaxParams - fixed) / perRow
+		if maxRows < 1 {
+			maxRows = 1
+		}
+		if size <= 0 || size > maxRows {
+			size = maxRows
+		}
+	}
+	if opts.MaxInsertRows > 0 && (size <= 0 || size > opts.MaxInsertRows) {
+		size = opts.MaxInsertRows
+	}
+	if size <= 0 {
+		size = math.MaxInt32
+	}
+	return size
+}
+
+// used internally to create a slice with the row repeated n times
+func repeatInsertRow(row interface{}, n int) []interface{} {
+	rows := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// used internally to execute each batch with the query factory
+func execInsertBatches(ctx context.Context, qf exec.QueryFactory, batches []*InsertDataset) (int64, error) {
+	var affected int64
+	for _, batch := range batches {
+		res, err := qf.FromSQLBuilder(batch.insertSQLBuilder()).WithOperation(exec.OperationInsert).ExecContext(ctx)
+		if err != nil {
+			return affected, err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return affected, err
+		}
+		affected += n
+	}
+	return affected, nil
+}
+
+// used internally to get the schema and table name from the INTO clause of a COPY FROM
+func copyFromTable(into exp.Expression) (schema, table string, err error) {
+	ident, ok := into.(exp.IdentifierExpression)
+	if !ok {
+		return "", "", errUnsupportedCopyFromTable(into)
+	}
+	if col, ok := ident.GetCol().(string); ok && col != "" {
+		if ident.GetSchema() != "" {
+			return "", "", errUnsupportedCopyFromTable(into)
+		}
+		return ident.GetTable(), col, nil
+	}
+	if ident.GetTable() == "" {
+		return "", "", errUnsupportedCopyFromTable(into)
+	}
+	return ident.GetSchema(), ident.GetTable(), nil
+}
+
+// used internally to get the name of a column for a COPY FROM
+func copyFromColumn(col interface{}) (string, bool) {
+	switch c := col.(type) {
+	case string:
+		return c, true
+	case exp.IdentifierExpression:
+		name, ok := c.GetCol().(string)
+		return name, ok && name != ""
+	}
+	return "", false
+}
+
+// used internally to check if a value was set to DEFAULT (e.g. an empty field tagged with defaultifempty)
+func isDefaultValue(val interface{}) bool {
+	le, ok := val.(exp.LiteralExpression)
+	return ok && le.Literal() == exp.Default().Literal() && len(le.Args()) == 0
+}
+
+func errCopyFromNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support COPY FROM [dialect=%s]", dialect)
+}
+
+func errUnsupportedCopyFromTable(into exp.Expression) error {
+	return errors.New("unsupported table %+v for COPY FROM, a table identifier is required", into)
+}
+
+func errUnsupportedCopyFromColumn(col interface{}) error {
+	return errors.New("unsupported column for COPY FROM, a column name is required received %T", col)
+}
+
+func errUnsupportedCopyFromValue(col string, val interface{}) error {
+	return errors.New("unsupported value for column %q, COPY FROM does not support expressions received %T", col, val)
+}
+
+func errCopyFromRowLength(expected, actual int) error {
+	return errors.New("rows with different lengths expected %d got %d", expected, actual)
+}
+
+// used internally to expand a single slice of rows (e.g. Rows([]Item{...})) into individual rows
+func flattenInsertRows(rows []interface{}) []interface{} {
+	if len(rows) != 1 {
+		return rows
+	}
+	val := reflect.ValueOf(rows[0])
+	if val.Kind() != reflect.Slice {
+		return rows
+	}
+	flattened := make([]interface{}, 0, val.Len())
+	for i := 0; i < val.Len(); i++ {
+		flattened = append(flattened, val.Index(i).Interface())
+	}
+	return flattened
 }
 
 func (id *InsertDataset) insertSQLBuilder() sb.SQLBuilder {
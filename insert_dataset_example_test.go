@@ -614,6 +614,52 @@ func ExampleInsertDataset_OnConflict_doUpdateWithWhere() {
 	// INSERT INTO "items" ("address", "name") VALUES ('111 Test Addr', 'Test1'), ('112 Test Addr', 'Test2') ON CONFLICT (key) DO UPDATE SET "updated"=NOW() WHERE ("allow_update" IS TRUE) []
 }
 
+func ExampleInsertDataset_OnConflict_doUpdateOnTarget() {
+	ds := goqu.Insert("items").Rows(goqu.Record{"name": "Test1", "address": "111 Test Addr"})
+
+	insertSQL, _, _ := ds.OnConflict(
+		goqu.DoUpdateOn(goqu.ConflictColumns("name", "address"), goqu.Record{"updated": goqu.L("NOW()")}),
+	).ToSQL()
+	fmt.Println(insertSQL)
+
+	insertSQL, _, _ = ds.OnConflict(goqu.DoUpdateOn(
+		goqu.ConflictColumns("name").Where(goqu.C("deleted_at").IsNull()),
+		goqu.Record{"updated": goqu.L("NOW()")},
+	)).ToSQL()
+	fmt.Println(insertSQL)
+
+	insertSQL, _, _ = ds.OnConflict(
+		goqu.DoUpdateOn(goqu.ConflictConstraint("items_name_key"), goqu.Record{"updated": goqu.L("NOW()")}),
+	).ToSQL()
+	fmt.Println(insertSQL)
+
+	// Output:
+	// INSERT INTO "items" ("address", "name") VALUES ('111 Test Addr', 'Test1') ON CONFLICT ("name", "address") DO UPDATE SET "updated"=NOW()
+	// INSERT INTO "items" ("address", "name") VALUES ('111 Test Addr', 'Test1') ON CONFLICT ("name") WHERE ("deleted_at" IS NULL) DO UPDATE SET "updated"=NOW()
+	// INSERT INTO "items" ("address", "name") VALUES ('111 Test Addr', 'Test1') ON CONFLICT ON CONSTRAINT "items_name_key" DO UPDATE SET "updated"=NOW()
+}
+
+func ExampleInsertDataset_Upsert() {
+	type item struct {
+		ID        uint32    `db:"id"`
+		Name      string    `db:"name"`
+		Address   string    `db:"address"`
+		CreatedAt time.Time `db:"created_at" goqu:"skipupdate"`
+	}
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ds := goqu.Insert("items").Rows(item{ID: 1, Name: "Test1", Address: "111 Test Addr", CreatedAt: created})
+
+	insertSQL, _, _ := ds.Upsert("id").ToSQL()
+	fmt.Println(insertSQL)
+
+	insertSQL, _, _ = ds.WithDialect("mysql").Upsert("id").ToSQL()
+	fmt.Println(insertSQL)
+
+	// Output:
+	// INSERT INTO "items" ("address", "created_at", "id", "name") VALUES ('111 Test Addr', '2020-01-01T00:00:00Z', 1, 'Test1') ON CONFLICT ("id") DO UPDATE SET "address"=EXCLUDED."address","name"=EXCLUDED."name"
+	// INSERT IGNORE INTO `items` (`address`, `created_at`, `id`, `name`) VALUES ('111 Test Addr', '2020-01-01 00:00:00', 1, 'Test1') ON DUPLICATE KEY UPDATE `address`=VALUES(`address`),`name`=VALUES(`name`)
+}
+
 func ExampleInsertDataset_Returning() {
 	insertSQL, _, _ := goqu.Insert("test").
 		Returning("id").
@@ -777,3 +823,34 @@ func ExampleInsertDataset_ClearVals() {
 	// INSERT INTO "test" DEFAULT VALUES
 	// INSERT INTO "test" DEFAULT VALUES
 }
+
+func ExampleInsertDataset_Batch() {
+	type item struct {
+		ID   int64  `db:"id"`
+		Name string `db:"name"`
+	}
+	ds := goqu.Insert("items").
+		Rows([]item{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}, {ID: 3, Name: "c"}}).
+		Batch(2)
+	batches, _ := ds.Batches()
+	for _, batch := range batches {
+		insertSQL, args, _ := batch.ToSQL()
+		fmt.Println(insertSQL, args)
+	}
+
+	// when prepared each batch is also limited by the MaxParams of the dialect
+	opts := goqu.DefaultDialectOptions()
+	opts.MaxParams = 4
+	goqu.RegisterDialect("max-params-example", opts)
+	defer goqu.DeregisterDialect("max-params-example")
+	batches, _ = ds.Batch(0).WithDialect("max-params-example").Prepared(true).Batches()
+	for _, batch := range batches {
+		insertSQL, args, _ := batch.ToSQL()
+		fmt.Println(insertSQL, args)
+	}
+	// Output:
+	// INSERT INTO "items" ("id", "name") VALUES (1, 'a'), (2, 'b') []
+	// INSERT INTO "items" ("id", "name") VALUES (3, 'c') []
+	// INSERT INTO "items" ("id", "name") VALUES (?, ?), (?, ?) [1 a 2 b]
+	// INSERT INTO "items" ("id", "name") VALUES (?, ?) [3 c]
+}
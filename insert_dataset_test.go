@@ -1,6 +1,7 @@
 package goqu_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -30,6 +31,36 @@ func (ids *insertDatasetSuite) assertCases(cases ...insertTestCase) {
 	}
 }
 
+func (ids *insertDatasetSuite) assertBatchesSQL(ds *goqu.InsertDataset, expectedSQL []string, expectedArgs [][]interface{}) {
+	batches, err := ds.Batches()
+	ids.Require().NoError(err)
+	ids.Require().Len(batches, len(expectedSQL))
+	for i, batch := range batches {
+		sql, args, err := batch.ToSQL()
+		ids.NoError(err)
+		ids.Equal(expectedSQL[i], sql)
+		if expectedArgs == nil {
+			ids.Empty(args)
+		} else {
+			ids.Equal(expectedArgs[i], args)
+		}
+	}
+}
+
+func (ids *insertDatasetSuite) SetupSuite() {
+	maxParams := goqu.DefaultDialectOptions()
+	maxParams.MaxParams = 5
+	goqu.RegisterDialect("max-params", maxParams)
+	maxInsertRows := goqu.DefaultDialectOptions()
+	maxInsertRows.MaxInsertRows = 2
+	goqu.RegisterDialect("max-insert-rows", maxInsertRows)
+}
+
+func (ids *insertDatasetSuite) TearDownSuite() {
+	goqu.DeregisterDialect("max-params")
+	goqu.DeregisterDialect("max-insert-rows")
+}
+
 func (ids *insertDatasetSuite) TestInsert() {
 	ds := goqu.Insert("test")
 	ids.IsType(&goqu.InsertDataset{}, ds)
@@ -369,6 +400,32 @@ func (ids *insertDatasetSuite) TestOnConflict() {
 	)
 }
 
+func (ids *insertDatasetSuite) TestUpsert() {
+	bd := goqu.Insert("items")
+	ids.assertCases(
+		insertTestCase{
+			ds: bd.Upsert("id", "name"),
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")).SetOnConflict(
+				exp.NewDoUpdateFromInsertConflictExpression(exp.NewConflictColumnsTarget("id", "name")),
+			),
+		},
+		insertTestCase{
+			ds: bd.Upsert(),
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")).SetOnConflict(
+				exp.NewDoUpdateFromInsertConflictExpression(exp.NewConflictColumnsTarget()),
+			),
+		},
+		insertTestCase{
+			ds:      bd.Upsert("id").ClearOnConflict(),
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")),
+		},
+		insertTestCase{
+			ds:      bd,
+			clauses: exp.NewInsertClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
 func (ids *insertDatasetSuite) TestAs() {
 	du := goqu.DoUpdate("other_items", goqu.Record{"new.a": 1})
 
@@ -526,6 +583,296 @@ func (ids *insertDatasetSuite) TestInsertStruct() {
 	ids.Equal(`INSERT INTO "items" ("address", "name") VALUES (?, ?)`, isql)
 }
 
+func (ids *insertDatasetSuite) TestBatch() {
+	type item struct {
+		ID   int64  `db:"id"`
+		Name string `db:"name"`
+	}
+	items := []item{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}, {ID: 3, Name: "c"}}
+	ds := goqu.Insert("items").Rows(items)
+
+	ids.assertBatchesSQL(ds, []string{
+		`INSERT INTO "items" ("id", "name") VALUES (1, 'a'), (2, 'b'), (3, 'c')`,
+	}, nil)
+	ids.assertBatchesSQL(ds.Batch(2), []string{
+		`INSERT INTO "items" ("id", "name") VALUES (1, 'a'), (2, 'b')`,
+		`INSERT INTO "items" ("id", "name") VALUES (3, 'c')`,
+	}, nil)
+	ids.assertBatchesSQL(goqu.Insert("items").Rows(items[0], items[1], items[2]).Batch(1).Prepared(true), []string{
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?)`,
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?)`,
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?)`,
+	}, [][]interface{}{{int64(1), "a"}, {int64(2), "b"}, {int64(3), "c"}})
+	ids.assertBatchesSQL(ds.Batch(2).Upsert("id"), []string{
+		`INSERT INTO "items" ("id", "name") VALUES (1, 'a'), (2, 'b') ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name"`,
+		`INSERT INTO "items" ("id", "name") VALUES (3, 'c') ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name"`,
+	}, nil)
+
+	valsDs := goqu.Insert("items").Cols("id", "name").Vals(
+		goqu.Vals{int64(1), "a"},
+		goqu.Vals{int64(2), "b"},
+		goqu.Vals{int64(3), "c"},
+	)
+	ids.assertBatchesSQL(valsDs.Batch(2), []string{
+		`INSERT INTO "items" ("id", "name") VALUES (1, 'a'), (2, 'b')`,
+		`INSERT INTO "items" ("id", "name") VALUES (3, 'c')`,
+	}, nil)
+
+	// the MaxParams of the dialect limits the number of rows when the dataset is prepared
+	ids.assertBatchesSQL(ds.WithDialect("max-params").Prepared(true), []string{
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?), (?, ?)`,
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?)`,
+	}, [][]interface{}{{int64(1), "a", int64(2), "b"}, {int64(3), "c"}})
+	ids.assertBatchesSQL(ds.WithDialect("max-params").Prepared(true).Batch(1), []string{
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?)`,
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?)`,
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?)`,
+	}, [][]interface{}{{int64(1), "a"}, {int64(2), "b"}, {int64(3), "c"}})
+	ids.assertBatchesSQL(ds.WithDialect("max-params"), []string{
+		`INSERT INTO "items" ("id", "name") VALUES (1, 'a'), (2, 'b'), (3, 'c')`,
+	}, nil)
+	// the params of the ON CONFLICT clause are subtracted from the MaxParams of the dialect
+	upsertDs := ds.WithDialect("max-params").Prepared(true).
+		OnConflict(goqu.DoUpdate("id", goqu.Record{"name": "z"}).Where(goqu.C("name").Neq("y")))
+	ids.assertBatchesSQL(upsertDs, []string{
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET "name"=? WHERE ("name" != ?)`,
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET "name"=? WHERE ("name" != ?)`,
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET "name"=? WHERE ("name" != ?)`,
+	}, [][]interface{}{{int64(1), "a", "z", "y"}, {int64(2), "b", "z", "y"}, {int64(3), "c", "z", "y"}})
+	valsUpsertDs := valsDs.WithDialect("max-params").Prepared(true).
+		OnConflict(goqu.DoUpdate("id", goqu.Record{"name": "z"}))
+	ids.assertBatchesSQL(valsUpsertDs, []string{
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?), (?, ?) ON CONFLICT (id) DO UPDATE SET "name"=?`,
+		`INSERT INTO "items" ("id", "name") VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET "name"=?`,
+	}, [][]interface{}{{int64(1), "a", int64(2), "b", "z"}, {int64(3), "c", "z"}})
+
+	// the MaxInsertRows of the dialect limits the number of rows with or without a prepared statement
+	ids.assertBatchesSQL(ds.WithDialect("max-insert-rows"), []string{
+		`INSERT INTO "items" ("id", "name") VALUES (1, 'a'), (2, 'b')`,
+		`INSERT INTO "items" ("id", "name") VALUES (3, 'c')`,
+	}, nil)
+	ids.assertBatchesSQL(ds.WithDialect("max-insert-rows").Batch(1), []string{
+		`INSERT INTO "items" ("id", "name") VALUES (1, 'a')`,
+		`INSERT INTO "items" ("id", "name") VALUES (2, 'b')`,
+		`INSERT INTO "items" ("id", "name") VALUES (3, 'c')`,
+	}, nil)
+
+	ids.assertBatchesSQL(goqu.Insert("items").FromQuery(goqu.From("other")).Batch(1), []string{
+		`INSERT INTO "items" SELECT * FROM "other"`,
+	}, nil)
+	ids.assertBatchesSQL(goqu.Insert("items").Batch(1), []string{
+		`INSERT INTO "items" DEFAULT VALUES`,
+	}, nil)
+
+	_, err := goqu.Insert("items").Rows(goqu.Record{"a": 1}, goqu.Record{"b": 1}).Batch(1).Batches()
+	ids.EqualError(err, "goqu: rows with different keys expected [\"a\"] got [\"b\"]")
+
+	expectedErr := errors.New("expected error")
+	_, err = ds.SetError(expectedErr).Batches()
+	ids.Equal(expectedErr, err)
+}
+
+func (ids *insertDatasetSuite) TestExecBatches() {
+	mDB, sqlMock, err := sqlmock.New()
+	ids.Require().NoError(err)
+	sqlMock.ExpectExec(`INSERT INTO "items" \("name"\) VALUES \(\$1\), \(\$2\)`).
+		WithArgs("a", "b").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectExec(`INSERT INTO "items" \("name"\) VALUES \(\$1\)`).
+		WithArgs("c").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`INSERT INTO "items" \("name"\) VALUES \(\$1\), \(\$2\)`).
+		WithArgs("a", "b").
+		WillReturnError(errors.New("insert error"))
+
+	ctx := context.Background()
+	ds := goqu.New("postgres", mDB).Insert("items").
+		Rows(goqu.Record{"name": "a"}, goqu.Record{"name": "b"}, goqu.Record{"name": "c"}).
+		Prepared(true).
+		Batch(2)
+
+	affected, err := ds.ExecBatches(ctx)
+	ids.NoError(err)
+	ids.Equal(int64(3), affected)
+
+	affected, err = ds.ExecBatches(ctx)
+	ids.EqualError(err, "goqu: insert error")
+	ids.Equal(int64(0), affected)
+	ids.NoError(sqlMock.ExpectationsWereMet())
+
+	_, err = goqu.Insert("items").Rows(goqu.Record{"name": "a"}).ExecBatches(ctx)
+	ids.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+}
+
+func (ids *insertDatasetSuite) TestExecBatches_InTx() {
+	mDB, sqlMock, err := sqlmock.New()
+	ids.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`INSERT INTO "items" \("name"\) VALUES \('a'\)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`INSERT INTO "items" \("name"\) VALUES \('b'\)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`INSERT INTO "items" \("name"\) VALUES \('a'\)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`INSERT INTO "items" \("name"\) VALUES \('b'\)`).WillReturnError(errors.New("insert error"))
+	sqlMock.ExpectRollback()
+
+	sqlMock.ExpectBegin().WillReturnError(errors.New("begin error"))
+
+	ctx := context.Background()
+	db := goqu.New("postgres", mDB)
+	ds := db.Insert("items").
+		Rows(goqu.Record{"name": "a"}, goqu.Record{"name": "b"}).
+		Batch(1).
+		BatchInTx(true)
+
+	affected, err := ds.ExecBatches(ctx)
+	ids.NoError(err)
+	ids.Equal(int64(2), affected)
+
+	affected, err = ds.ExecBatches(ctx)
+	ids.EqualError(err, "goqu: insert error")
+	ids.Equal(int64(1), affected)
+
+	_, err = ds.ExecBatches(ctx)
+	ids.EqualError(err, "goqu: begin error")
+	ids.NoError(sqlMock.ExpectationsWereMet())
+
+	_, err = db.From("items").Insert().Rows(goqu.Record{"name": "a"}).BatchInTx(true).ExecBatches(ctx)
+	ids.Equal(goqu.ErrBatchTxNotSupported, err)
+}
+
+func (ids *insertDatasetSuite) TestExecBatches_InExistingTx() {
+	mDB, sqlMock, err := sqlmock.New()
+	ids.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`INSERT INTO "items" \("name"\) VALUES \('a'\)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`INSERT INTO "items" \("name"\) VALUES \('b'\)`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := goqu.New("postgres", mDB).Begin()
+	ids.Require().NoError(err)
+	affected, err := tx.Insert("items").
+		Rows(goqu.Record{"name": "a"}, goqu.Record{"name": "b"}).
+		Batch(1).
+		BatchInTx(true).
+		ExecBatches(ctx)
+	ids.NoError(err)
+	ids.Equal(int64(2), affected)
+	ids.NoError(tx.Commit())
+	ids.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (ids *insertDatasetSuite) TestCopyFrom() {
+	type item struct {
+		ID      int64  `db:"id" goqu:"defaultifempty"`
+		Name    string `db:"name"`
+		Created string `db:"created" goqu:"skipinsert"`
+	}
+	mDB, sqlMock, err := sqlmock.New()
+	ids.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	copyStmt := sqlMock.ExpectPrepare(`COPY "items" \("name"\) FROM STDIN`)
+	copyStmt.ExpectExec().WithArgs("a").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs("b").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectCommit()
+
+	sqlMock.ExpectBegin()
+	copyStmt = sqlMock.ExpectPrepare(`COPY "public"."items" \("id", "name"\) FROM STDIN`)
+	copyStmt.ExpectExec().WithArgs(int64(1), "a").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs(int64(2), "b").WillReturnError(errors.New("copy error"))
+	sqlMock.ExpectRollback()
+
+	ctx := context.Background()
+	db := goqu.New("postgres", mDB)
+	copied, err := db.Insert("items").
+		Rows([]item{{Name: "a", Created: "now"}, {Name: "b"}}).
+		CopyFrom(ctx)
+	ids.NoError(err)
+	ids.Equal(int64(2), copied)
+
+	copied, err = db.Insert("public.items").
+		Cols("id", "name").
+		Vals(goqu.Vals{int64(1), "a"}, goqu.Vals{int64(2), "b"}).
+		CopyFrom(ctx)
+	ids.EqualError(err, "goqu: copy error")
+	ids.Equal(int64(0), copied)
+	ids.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (ids *insertDatasetSuite) TestCopyFrom_PartialDefaults() {
+	type item struct {
+		ID   int64  `db:"id" goqu:"defaultifempty"`
+		Name string `db:"name" goqu:"defaultifempty"`
+	}
+	mDB, sqlMock, err := sqlmock.New()
+	ids.Require().NoError(err)
+	sqlMock.ExpectBegin()
+	// consecutive rows with the same columns that are not DEFAULT are copied together, in the order of the rows
+	copyStmt := sqlMock.ExpectPrepare(`COPY "items" \("id", "name"\) FROM STDIN`)
+	copyStmt.ExpectExec().WithArgs(int64(1), "a").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs(int64(2), "b").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 2))
+	copyStmt = sqlMock.ExpectPrepare(`COPY "items" \("name"\) FROM STDIN`)
+	copyStmt.ExpectExec().WithArgs("c").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 1))
+	// rows that are DEFAULT for every column can not be copied
+	sqlMock.ExpectExec(`INSERT INTO "items" DEFAULT VALUES`).WillReturnResult(sqlmock.NewResult(0, 1))
+	copyStmt = sqlMock.ExpectPrepare(`COPY "items" \("id", "name"\) FROM STDIN`)
+	copyStmt.ExpectExec().WithArgs(int64(4), "d").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 1))
+	copyStmt = sqlMock.ExpectPrepare(`COPY "items" \("name"\) FROM STDIN`)
+	copyStmt.ExpectExec().WithArgs("e").WillReturnResult(sqlmock.NewResult(0, 0))
+	copyStmt.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	copied, err := goqu.New("postgres", mDB).Insert("items").
+		Rows([]item{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}, {Name: "c"}, {}, {ID: 4, Name: "d"}, {Name: "e"}}).
+		CopyFrom(context.Background())
+	ids.NoError(err)
+	ids.Equal(int64(6), copied)
+	ids.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (ids *insertDatasetSuite) TestCopyFrom_Errors() {
+	type item struct {
+		ID   int64  `db:"id" goqu:"defaultifempty"`
+		Name string `db:"name"`
+	}
+	mDB, sqlMock, err := sqlmock.New()
+	ids.Require().NoError(err)
+
+	ctx := context.Background()
+	db := goqu.New("postgres", mDB)
+	_, err = goqu.New("mock", mDB).Insert("items").Rows(item{Name: "a"}).CopyFrom(ctx)
+	ids.EqualError(err, "goqu: dialect does not support COPY FROM [dialect=default]")
+
+	_, err = db.Insert(goqu.T("items").As("i")).Rows(item{Name: "a"}).CopyFrom(ctx)
+	ids.Error(err)
+
+	_, err = db.Insert("items").CopyFrom(ctx)
+	ids.Equal(goqu.ErrNoRowsForCopyFrom, err)
+
+	_, err = db.Insert("items").FromQuery(goqu.From("other")).CopyFrom(ctx)
+	ids.Equal(goqu.ErrNoRowsForCopyFrom, err)
+
+	_, err = db.Insert("items").Cols("name").Vals(goqu.Vals{goqu.L("NOW()")}).CopyFrom(ctx)
+	ids.EqualError(err, `goqu: unsupported value for column "name", COPY FROM does not support expressions received exp.literal`)
+
+	_, err = db.Insert("items").Cols(goqu.L("name")).Vals(goqu.Vals{"a"}).CopyFrom(ctx)
+	ids.EqualError(err, `goqu: unsupported column for COPY FROM, a column name is required received exp.literal`)
+
+	_, err = goqu.Dialect("postgres").Insert("items").Rows(item{Name: "a"}).CopyFrom(ctx)
+	ids.Equal(goqu.ErrCopyFromTxNotSupported, err)
+
+	expectedErr := errors.New("expected error")
+	_, err = db.Insert("items").Rows(item{Name: "a"}).SetError(expectedErr).CopyFrom(ctx)
+	ids.Equal(expectedErr, err)
+	ids.NoError(sqlMock.ExpectationsWereMet())
+}
+
 func (ids *insertDatasetSuite) TestToSQL() {
 	md := new(mocks.SQLDialect)
 	ds := goqu.Insert("test").SetDialect(md)
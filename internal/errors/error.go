@@ -2,18 +2,95 @@ package errors
 
 import "fmt"
 
+// The kind of an Error, used to classify errors without matching on the message.
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	// The dialect does not support a feature used by the statement (e.g. RETURNING, MERGE)
+	KindUnsupportedFeature
+	// A value could not be encoded into SQL
+	KindEncode
+	// The destination of a scan is not a supported type
+	KindScanMismatch
+	// A column returned by a query does not have a corresponding struct field
+	KindMissingField
+)
+
 type Error struct {
-	err string
+	kind Kind
+	err  string
+}
+
+// Sentinel errors for each kind, an Error matches the sentinel for its kind when using errors.Is.
+var (
+	ErrUnsupportedFeature = Error{kind: KindUnsupportedFeature, err: "goqu: unsupported feature"}
+	ErrEncode             = Error{kind: KindEncode, err: "goqu_encode_error: unable to encode value"}
+	ErrScanMismatch       = Error{kind: KindScanMismatch, err: "goqu: scan mismatch"}
+	ErrMissingField       = Error{kind: KindMissingField, err: "goqu: missing field"}
+)
+
+var sentinels = map[Kind]Error{
+	KindUnsupportedFeature: ErrUnsupportedFeature,
+	KindEncode:             ErrEncode,
+	KindScanMismatch:       ErrScanMismatch,
+	KindMissingField:       ErrMissingField,
 }
 
 func New(message string, args ...interface{}) error {
-	return Error{err: "goqu: " + fmt.Sprintf(message, args...)}
+	return newKind(KindUnknown, message, args...)
 }
 
 func NewEncodeError(t interface{}) error {
-	return Error{err: "goqu_encode_error: " + fmt.Sprintf("Unable to encode value %+v", t)}
+	return Error{kind: KindEncode, err: "goqu_encode_error: " + fmt.Sprintf("Unable to encode value %+v", t)}
+}
+
+func NewUnsupportedFeatureError(message string, args ...interface{}) error {
+	return newKind(KindUnsupportedFeature, message, args...)
+}
+
+func NewScanMismatchError(message string, args ...interface{}) error {
+	return newKind(KindScanMismatch, message, args...)
+}
+
+func NewMissingFieldError(message string, args ...interface{}) error {
+	return newKind(KindMissingField, message, args...)
+}
+
+func newKind(kind Kind, message string, args ...interface{}) error {
+	return Error{kind: kind, err: "goqu: " + fmt.Sprintf(message, args...)}
 }
 
 func (e Error) Error() string {
 	return e.err
 }
+
+// Returns the kind of the error.
+func (e Error) Kind() Kind {
+	return e.kind
+}
+
+// Returns true if target is the sentinel error for the kind of the error (e.g. ErrUnsupportedFeature).
+func (e Error) Is(target error) bool {
+	t, ok := target.(Error)
+	if !ok {
+		return false
+	}
+	sentinel, ok := sentinels[e.kind]
+	return ok && t == sentinel
+}
+
+func (k Kind) String() string {
+	switch k {
+	case KindUnsupportedFeature:
+		return "unsupported feature"
+	case KindEncode:
+		return "encode"
+	case KindScanMismatch:
+		return "scan mismatch"
+	case KindMissingField:
+		return "missing field"
+	default:
+		return "unknown"
+	}
+}
@@ -2,8 +2,12 @@ package sb
 
 import (
 	"bytes"
+	"strings"
 )
 
+// The value written in place of sensitive values by ToRedactedSQL. This must match exp.RedactedValue
+const redactedValue = "[REDACTED]"
+
 // Builder that is composed of a bytes.Buffer. It is used internally and by adapters to build SQL statements
 type (
 	SQLBuilder interface {
@@ -15,7 +19,17 @@ type (
 		WriteRunes(r ...rune) SQLBuilder
 		IsPrepared() bool
 		CurrentArgPosition() int
+		StartSensitive() SQLBuilder
+		EndSensitive() SQLBuilder
+		IsSensitive() bool
+		HasSensitive() bool
 		ToSQL() (sql string, args []interface{}, err error)
+		ToRedactedSQL() (sql string, args []interface{}, err error)
+	}
+	// the positions of a sensitive value written to the builder
+	sensitiveRange struct {
+		bufStart, bufEnd int
+		argStart, argEnd int
 	}
 	sqlBuilder struct {
 		buf *bytes.Buffer
@@ -25,6 +39,10 @@ type (
 		currentArgPosition int
 		args               []interface{}
 		err                error
+		// The number of StartSensitive calls that have not been ended
+		sensitiveDepth  int
+		sensitiveStart  sensitiveRange
+		sensitiveRanges []sensitiveRange
 	}
 )
 
@@ -92,6 +110,39 @@ func (b *sqlBuilder) WriteArg(i ...interface{}) SQLBuilder {
 	return b
 }
 
+// Marks the start of a sensitive value, everything written until EndSensitive is redacted by ToRedactedSQL
+func (b *sqlBuilder) StartSensitive() SQLBuilder {
+	if b.sensitiveDepth == 0 {
+		b.sensitiveStart = sensitiveRange{bufStart: b.buf.Len(), argStart: len(b.args)}
+	}
+	b.sensitiveDepth++
+	return b
+}
+
+// Marks the end of a sensitive value started with StartSensitive
+func (b *sqlBuilder) EndSensitive() SQLBuilder {
+	if b.sensitiveDepth == 0 {
+		return b
+	}
+	b.sensitiveDepth--
+	if b.sensitiveDepth == 0 {
+		r := b.sensitiveStart
+		r.bufEnd, r.argEnd = b.buf.Len(), len(b.args)
+		b.sensitiveRanges = append(b.sensitiveRanges, r)
+	}
+	return b
+}
+
+// Returns true if a sensitive value is currently being written
+func (b *sqlBuilder) IsSensitive() bool {
+	return b.sensitiveDepth > 0
+}
+
+// Returns true if any sensitive values have been written
+func (b *sqlBuilder) HasSensitive() bool {
+	return len(b.sensitiveRanges) > 0
+}
+
 // Returns the sql string, and arguments.
 func (b *sqlBuilder) ToSQL() (sql string, args []interface{}, err error) {
 	if b.err != nil {
@@ -99,3 +150,28 @@ func (b *sqlBuilder) ToSQL() (sql string, args []interface{}, err error) {
 	}
 	return b.buf.String(), b.args, nil
 }
+
+// Returns the sql string and arguments with every sensitive value replaced. Interpolated values are replaced in the sql
+// string and prepared values are replaced in the arguments, placeholders are left as is.
+func (b *sqlBuilder) ToRedactedSQL() (sql string, args []interface{}, err error) {
+	sql, args, err = b.ToSQL()
+	if err != nil || !b.HasSensitive() {
+		return sql, args, err
+	}
+	redactedArgs := make([]interface{}, len(args))
+	copy(redactedArgs, args)
+	var buf strings.Builder
+	bufPos := 0
+	for _, r := range b.sensitiveRanges {
+		for i := r.argStart; i < r.argEnd; i++ {
+			redactedArgs[i] = redactedValue
+		}
+		if !b.isPrepared && r.bufEnd > r.bufStart {
+			buf.WriteString(sql[bufPos:r.bufStart])
+			buf.WriteString(redactedValue)
+			bufPos = r.bufEnd
+		}
+	}
+	buf.WriteString(sql[bufPos:])
+	return buf.String(), redactedArgs, nil
+}
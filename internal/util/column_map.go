@@ -17,6 +17,7 @@ type (
 		DefaultIfEmpty bool
 		OmitNil        bool
 		OmitEmpty      bool
+		Sensitive      bool
 		GoType         reflect.Type
 	}
 	ColumnMap map[string]ColumnData
@@ -95,6 +96,7 @@ func newColumnData(f *reflect.StructField, columnName string, fieldIndex []int,
 		DefaultIfEmpty: goquTag.Contains(defaultIfEmptyTagName),
 		OmitNil:        goquTag.Contains(omitNilTagName),
 		OmitEmpty:      goquTag.Contains(omitEmptyTagName),
+		Sensitive:      goquTag.Contains(sensitiveTagName),
 		FieldIndex:     concatFieldIndexes(fieldIndex, f.Index),
 		GoType:         f.Type,
 	}
@@ -15,6 +15,7 @@ const (
 	defaultIfEmptyTagName = "defaultifempty"
 	omitNilTagName        = "omitnil"
 	omitEmptyTagName      = "omitempty"
+	sensitiveTagName      = "sensitive"
 )
 
 var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
@@ -205,7 +206,7 @@ func GetColumnMap(i interface{}) (ColumnMap, error) {
 	val := reflect.Indirect(reflect.ValueOf(i))
 	t, valKind := GetTypeInfo(i, val)
 	if valKind != reflect.Struct {
-		return nil, errors.New("cannot scan into this type: %v", t) // #nosec
+		return nil, errors.NewScanMismatchError("cannot scan into this type: %v", t) // #nosec
 	}
 
 	structMapCacheLock.Lock()
@@ -215,3 +216,17 @@ func GetColumnMap(i interface{}) (ColumnMap, error) {
 	}
 	return structMapCache[t], nil
 }
+
+// IsStructScanType returns true if values of type t should be scanned column by column using a ColumnMap rather
+// than as a single value. Pointers are dereferenced, and types implementing sql.Scanner or structs without any
+// mapped columns (e.g. time.Time) are scanned as a single value.
+func IsStructScanType(t reflect.Type) bool {
+	if IsPointer(t.Kind()) {
+		t = t.Elem()
+	}
+	if implementsScanner(t) {
+		return false
+	}
+	cm, err := GetColumnMap(reflect.New(t).Interface())
+	return err == nil && len(cm) > 0
+}
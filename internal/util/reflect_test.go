@@ -718,6 +718,7 @@ func (rt *reflectTest) TestGetColumnMap_withStructGoquTags() {
 		OmitNil   bool   `goqu:"omitnil"`
 		OmitEmpty bool   `goqu:"omitempty"`
 		Valuer    *sql.NullString
+		Sensitive string `goqu:"sensitive"`
 	}
 	var ts TestStruct
 	cm, err := util.GetColumnMap(&ts)
@@ -751,6 +752,14 @@ func (rt *reflectTest) TestGetColumnMap_withStructGoquTags() {
 			GoType:       reflect.TypeOf(true),
 		},
 		"valuer": {ColumnName: "valuer", FieldIndex: []int{6}, ShouldInsert: true, ShouldUpdate: true, GoType: reflect.TypeOf(&sql.NullString{})},
+		"sensitive": {
+			ColumnName:   "sensitive",
+			FieldIndex:   []int{7},
+			ShouldInsert: true,
+			ShouldUpdate: true,
+			Sensitive:    true,
+			GoType:       reflect.TypeOf(""),
+		},
 	}, cm)
 }
 
@@ -1341,6 +1350,22 @@ func (rt *reflectTest) TestAppendSliceElement() {
 	rt.Equal([]*MyStruct{{}}, sliceVal.Interface())
 }
 
+func (rt *reflectTest) TestIsStructScanType() {
+	type MyStruct struct {
+		Name string `db:"name"`
+	}
+	type EmptyStruct struct{}
+
+	rt.True(util.IsStructScanType(reflect.TypeOf(MyStruct{})))
+	rt.True(util.IsStructScanType(reflect.TypeOf(&MyStruct{})))
+	rt.False(util.IsStructScanType(reflect.TypeOf(EmptyStruct{})))
+	rt.False(util.IsStructScanType(reflect.TypeOf(time.Time{})))
+	rt.False(util.IsStructScanType(reflect.TypeOf(sql.NullString{})))
+	rt.False(util.IsStructScanType(reflect.TypeOf(&sql.NullString{})))
+	rt.False(util.IsStructScanType(reflect.TypeOf("")))
+	rt.False(util.IsStructScanType(reflect.TypeOf(int64(1))))
+}
+
 func TestReflectSuite(t *testing.T) {
 	suite.Run(t, new(reflectTest))
 }
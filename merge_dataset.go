@@ -0,0 +1,221 @@
+package goqu
+
+import (
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type MergeDataset struct {
+	dialect      SQLDialect
+	clauses      exp.MergeClauses
+	isPrepared   prepared
+	queryFactory exec.QueryFactory
+	err          error
+}
+
+var (
+	ErrUnsupportedMergeTargetType = errors.New(
+		"unsupported merge target type, a string or identifier expression is required",
+	)
+	ErrUnsupportedMergeSourceType = errors.New(
+		"unsupported merge source type, a string, identifier expression or dataset is required",
+	)
+)
+
+// used internally by database to create a database with a specific adapter
+func newMergeDataset(d string, queryFactory exec.QueryFactory) *MergeDataset {
+	return &MergeDataset{
+		clauses:      exp.NewMergeClauses(),
+		dialect:      GetDialect(d),
+		queryFactory: queryFactory,
+		isPrepared:   preparedNoPreference,
+		err:          nil,
+	}
+}
+
+// Creates a new MergeDataset for the target table. See examples.
+//
+//	Merge("items").
+//		Using(T("new_items").As("n")).
+//		On(I("items.id").Eq(I("n.id"))).
+//		WhenMatched(MergeUpdate(Record{"name": I("n.name")})).
+//		WhenNotMatched(MergeInsert(Record{"id": I("n.id"), "name": I("n.name")}))
+func Merge(target interface{}) *MergeDataset {
+	return newMergeDataset("default", nil).Into(target)
+}
+
+func (md *MergeDataset) Expression() exp.Expression {
+	return md
+}
+
+// Clones the dataset
+func (md *MergeDataset) Clone() exp.Expression {
+	return md.copy(md.clauses)
+}
+
+// Set the parameter interpolation behavior. See examples
+//
+// prepared: If true the dataset WILL NOT interpolate the parameters.
+func (md *MergeDataset) Prepared(prepared bool) *MergeDataset {
+	ret := md.copy(md.clauses)
+	ret.isPrepared = preparedFromBool(prepared)
+	return ret
+}
+
+// Returns true if Prepared(true) has been called on this dataset
+func (md *MergeDataset) IsPrepared() bool {
+	return md.isPrepared.Bool()
+}
+
+// Sets the adapter used to serialize values and create the SQL statement
+func (md *MergeDataset) WithDialect(dl string) *MergeDataset {
+	ds := md.copy(md.GetClauses())
+	ds.dialect = GetDialect(dl)
+	return ds
+}
+
+// Returns the current SQLDialect on the dataset
+func (md *MergeDataset) Dialect() SQLDialect {
+	return md.dialect
+}
+
+// Set the dialect for this dataset.
+func (md *MergeDataset) SetDialect(dialect SQLDialect) *MergeDataset {
+	cd := md.copy(md.GetClauses())
+	cd.dialect = dialect
+	return cd
+}
+
+// Returns the current clauses on the dataset.
+func (md *MergeDataset) GetClauses() exp.MergeClauses {
+	return md.clauses
+}
+
+// used interally to copy the dataset
+func (md *MergeDataset) copy(clauses exp.MergeClauses) *MergeDataset {
+	return &MergeDataset{
+		dialect:      md.dialect,
+		clauses:      clauses,
+		isPrepared:   md.isPrepared,
+		queryFactory: md.queryFactory,
+		err:          md.err,
+	}
+}
+
+// Creates a WITH clause for a common table expression (CTE).
+//
+// The name will be available to use in the USING clause of the associated query; and can optionally
+// contain a list of column names "name(col1, col2, col3)".
+//
+// The name will refer to the results of the specified subquery.
+func (md *MergeDataset) With(name string, subquery exp.Expression) *MergeDataset {
+	return md.copy(md.clauses.CommonTablesAppend(exp.NewCommonTableExpression(false, name, subquery)))
+}
+
+// Creates a WITH RECURSIVE clause for a common table expression (CTE)
+//
+// The name will be available to use in the USING clause of the associated query; and must
+// contain a list of column names "name(col1, col2, col3)" for a recursive clause.
+func (md *MergeDataset) WithRecursive(name string, subquery exp.Expression) *MergeDataset {
+	return md.copy(md.clauses.CommonTablesAppend(exp.NewCommonTableExpression(true, name, subquery)))
+}
+
+// Sets the target table of the MERGE statement. See examples.
+// You can pass in the following.
+//
+//	string: Will automatically be turned into an identifier
+//	IdentifierExpression: The table to merge into
+//	AliasedExpression: The table to merge into with an alias (e.g. T("items").As("i"))
+func (md *MergeDataset) Into(target interface{}) *MergeDataset {
+	switch t := target.(type) {
+	case exp.IdentifierExpression, exp.AliasedExpression:
+		return md.copy(md.clauses.SetInto(t.(exp.Expression)))
+	case string:
+		return md.copy(md.clauses.SetInto(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedMergeTargetType)
+	}
+}
+
+// Sets the source of the MERGE statement. See examples.
+// You can pass in the following.
+//
+//	string: Will automatically be turned into an identifier
+//	Expression: An identifier, aliased expression or dataset to merge from. Datasets should be aliased
+//	  (e.g. From("new_items").As("n"))
+func (md *MergeDataset) Using(source interface{}) *MergeDataset {
+	switch t := source.(type) {
+	case exp.Expression:
+		return md.copy(md.clauses.SetUsing(t))
+	case string:
+		return md.copy(md.clauses.SetUsing(exp.ParseIdentifier(t)))
+	default:
+		panic(ErrUnsupportedMergeSourceType)
+	}
+}
+
+// Adds conditions to the ON clause used to match rows between the target and the source. Multiple conditions are
+// ANDed together. See examples.
+func (md *MergeDataset) On(conditions ...exp.Expression) *MergeDataset {
+	return md.copy(md.clauses.OnAppend(conditions...))
+}
+
+// Adds a WHEN MATCHED clause. The action may be MergeUpdate, MergeDelete or MergeDoNothing. Clauses are generated in
+// the order they were added. See examples.
+func (md *MergeDataset) WhenMatched(action exp.MergeAction) *MergeDataset {
+	return md.copy(md.clauses.WhensAppend(exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, action)))
+}
+
+// Adds a WHEN NOT MATCHED clause. The action may be MergeInsert or MergeDoNothing. Clauses are generated in the
+// order they were added. See examples.
+func (md *MergeDataset) WhenNotMatched(action exp.MergeAction) *MergeDataset {
+	return md.copy(md.clauses.WhensAppend(exp.NewMergeWhenExpression(exp.WhenNotMatchedMergeType, action)))
+}
+
+// Get any error that has been set or nil if no error has been set.
+func (md *MergeDataset) Error() error {
+	return md.err
+}
+
+// Set an error on the dataset if one has not already been set. This error will be returned by a future call to Error
+// or as part of ToSQL. This can be used by end users to record errors while building up queries without having to
+// track those separately.
+func (md *MergeDataset) SetError(err error) *MergeDataset {
+	if md.err == nil {
+		md.err = err
+	}
+
+	return md
+}
+
+// Generates a MERGE sql statement, if Prepared has been called with true then the parameters will not be interpolated.
+// See examples.
+//
+// Errors:
+//   - The dialect does not support MERGE statements
+//   - There is no target, source, ON condition or WHEN clause
+//   - There is an error generating the SQL
+func (md *MergeDataset) ToSQL() (sql string, params []interface{}, err error) {
+	return md.mergeSQLBuilder().ToSQL()
+}
+
+// Creates an QueryExecutor to execute the query.
+//
+//	db.Merge("test").Using("other").On(...).WhenMatched(...).Executor().Exec()
+//
+// See Dataset#ToSQL for arguments
+func (md *MergeDataset) Executor() exec.QueryExecutor {
+	return md.queryFactory.FromSQLBuilder(md.mergeSQLBuilder()).WithOperation(exec.OperationMerge).
+		WithColumnConverter(md.dialect.DialectOptions().ConvertColumnValue)
+}
+
+func (md *MergeDataset) mergeSQLBuilder() sb.SQLBuilder {
+	buf := sb.NewSQLBuilder(md.isPrepared.Bool())
+	if md.err != nil {
+		return buf.SetError(md.err)
+	}
+	md.dialect.ToMergeSQL(buf, md.clauses)
+	return buf
+}
@@ -0,0 +1,89 @@
+package goqu_test
+
+import (
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
+)
+
+func ExampleMerge() {
+	ds := goqu.Merge(goqu.T("items").As("i")).
+		Using(goqu.T("new_items").As("n")).
+		On(goqu.I("i.id").Eq(goqu.I("n.id"))).
+		WhenMatched(goqu.MergeUpdate(goqu.Record{"name": goqu.I("n.name")})).
+		WhenNotMatched(goqu.MergeInsert(goqu.Record{"id": goqu.I("n.id"), "name": goqu.I("n.name")}))
+
+	sql, args, _ := ds.ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// MERGE INTO "items" AS "i" USING "new_items" AS "n" ON ("i"."id" = "n"."id") WHEN MATCHED THEN UPDATE SET "name"="n"."name" WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("n"."id", "n"."name") []
+}
+
+func ExampleMergeDataset_Using() {
+	source := goqu.From("new_items").Where(goqu.C("active").IsTrue()).As("n")
+	sql, _, _ := goqu.Merge("items").
+		Using(source).
+		On(goqu.I("items.id").Eq(goqu.I("n.id"))).
+		WhenMatched(goqu.MergeUpdate(goqu.Record{"name": goqu.I("n.name")})).
+		ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// MERGE INTO "items" USING (SELECT * FROM "new_items" WHERE ("active" IS TRUE)) AS "n" ON ("items"."id" = "n"."id") WHEN MATCHED THEN UPDATE SET "name"="n"."name"
+}
+
+func ExampleMergeDataset_WhenMatched() {
+	sql, _, _ := goqu.Merge("items").
+		Using(goqu.T("new_items").As("n")).
+		On(goqu.I("items.id").Eq(goqu.I("n.id"))).
+		WhenMatched(goqu.MergeDelete().Where(goqu.I("n.deleted").IsTrue())).
+		WhenMatched(goqu.MergeUpdate(goqu.Record{"name": goqu.I("n.name")})).
+		ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// MERGE INTO "items" USING "new_items" AS "n" ON ("items"."id" = "n"."id") WHEN MATCHED AND ("n"."deleted" IS TRUE) THEN DELETE WHEN MATCHED THEN UPDATE SET "name"="n"."name"
+}
+
+func ExampleMergeDataset_WhenNotMatched() {
+	sql, _, _ := goqu.Merge("items").
+		Using(goqu.T("new_items").As("n")).
+		On(goqu.I("items.id").Eq(goqu.I("n.id"))).
+		WhenMatched(goqu.MergeDoNothing()).
+		WhenNotMatched(goqu.MergeInsert(goqu.Record{"id": goqu.I("n.id"), "name": goqu.I("n.name")})).
+		ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// MERGE INTO "items" USING "new_items" AS "n" ON ("items"."id" = "n"."id") WHEN MATCHED THEN DO NOTHING WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("n"."id", "n"."name")
+}
+
+func ExampleMergeDataset_Prepared() {
+	sql, args, _ := goqu.Merge("items").
+		Prepared(true).
+		Using(goqu.T("new_items").As("n")).
+		On(goqu.I("items.id").Eq(goqu.I("n.id"))).
+		WhenMatched(goqu.MergeUpdate(goqu.Record{"name": "Test"})).
+		WhenNotMatched(goqu.MergeInsert(goqu.Record{"id": goqu.I("n.id"), "name": "Test"})).
+		ToSQL()
+	fmt.Println(sql, args)
+
+	// Output:
+	// MERGE INTO "items" USING "new_items" AS "n" ON ("items"."id" = "n"."id") WHEN MATCHED THEN UPDATE SET "name"=? WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("n"."id", ?) [Test Test]
+}
+
+func ExampleMergeDataset_ToSQL_sqlserver() {
+	sql, _, _ := goqu.Dialect("sqlserver").
+		Merge(goqu.T("items").As("i")).
+		Using(goqu.T("new_items").As("n")).
+		On(goqu.I("i.id").Eq(goqu.I("n.id"))).
+		WhenMatched(goqu.MergeUpdate(goqu.Record{"name": goqu.I("n.name")})).
+		WhenNotMatched(goqu.MergeInsert(goqu.Record{"id": goqu.I("n.id"), "name": goqu.I("n.name")})).
+		ToSQL()
+	fmt.Println(sql)
+
+	// Output:
+	// MERGE INTO "items" AS "i" USING "new_items" AS "n" ON ("i"."id" = "n"."id") WHEN MATCHED THEN UPDATE SET "name"="n"."name" WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("n"."id", "n"."name");
+}
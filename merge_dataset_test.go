@@ -0,0 +1,341 @@
+package goqu_test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	mergeTestCase struct {
+		ds      *goqu.MergeDataset
+		clauses exp.MergeClauses
+	}
+	mergeDatasetSuite struct {
+		suite.Suite
+	}
+)
+
+func (mds *mergeDatasetSuite) assertCases(cases ...mergeTestCase) {
+	for _, s := range cases {
+		mds.Equal(s.clauses, s.ds.GetClauses())
+	}
+}
+
+func (mds *mergeDatasetSuite) TestMerge() {
+	ds := goqu.Merge("test")
+	mds.IsType(&goqu.MergeDataset{}, ds)
+	mds.Implements((*exp.Expression)(nil), ds)
+}
+
+func (mds *mergeDatasetSuite) TestClone() {
+	ds := goqu.Merge("test")
+	mds.Equal(ds.Clone(), ds)
+}
+
+func (mds *mergeDatasetSuite) TestExpression() {
+	ds := goqu.Merge("test")
+	mds.Equal(ds.Expression(), ds)
+}
+
+func (mds *mergeDatasetSuite) TestDialect() {
+	ds := goqu.Merge("test")
+	mds.NotNil(ds.Dialect())
+}
+
+func (mds *mergeDatasetSuite) TestWithDialect() {
+	ds := goqu.Merge("test")
+	md := new(mocks.SQLDialect)
+	ds = ds.SetDialect(md)
+
+	dialect := goqu.GetDialect("default")
+	dialectDs := ds.WithDialect("default")
+	mds.Equal(md, ds.Dialect())
+	mds.Equal(dialect, dialectDs.Dialect())
+}
+
+func (mds *mergeDatasetSuite) TestPrepared() {
+	ds := goqu.Merge("test")
+	preparedDs := ds.Prepared(true)
+	mds.True(preparedDs.IsPrepared())
+	mds.False(ds.IsPrepared())
+	// should apply the prepared to any datasets created from the root
+	mds.True(preparedDs.Using("other").IsPrepared())
+
+	defer goqu.SetDefaultPrepared(false)
+	goqu.SetDefaultPrepared(true)
+
+	// should be prepared by default
+	ds = goqu.Merge("test")
+	mds.True(ds.IsPrepared())
+}
+
+func (mds *mergeDatasetSuite) TestGetClauses() {
+	ds := goqu.Merge("test")
+	ce := exp.NewMergeClauses().SetInto(goqu.I("test"))
+	mds.Equal(ce, ds.GetClauses())
+}
+
+func (mds *mergeDatasetSuite) TestWith() {
+	from := goqu.From("cte")
+	bd := goqu.Merge("items")
+	mds.assertCases(
+		mergeTestCase{
+			ds: bd.With("test-cte", from),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).
+				CommonTablesAppend(exp.NewCommonTableExpression(false, "test-cte", from)),
+		},
+		mergeTestCase{
+			ds: bd.WithRecursive("test-cte", from),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).
+				CommonTablesAppend(exp.NewCommonTableExpression(true, "test-cte", from)),
+		},
+		mergeTestCase{
+			ds:      bd,
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
+func (mds *mergeDatasetSuite) TestInto() {
+	bd := goqu.Merge("items")
+	mds.assertCases(
+		mergeTestCase{
+			ds:      bd.Into("items2"),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items2")),
+		},
+		mergeTestCase{
+			ds:      bd.Into(goqu.T("items2")),
+			clauses: exp.NewMergeClauses().SetInto(goqu.T("items2")),
+		},
+		mergeTestCase{
+			ds:      bd.Into(goqu.T("items2").As("i")),
+			clauses: exp.NewMergeClauses().SetInto(goqu.T("items2").As("i")),
+		},
+		mergeTestCase{
+			ds:      bd.Into("schema.table"),
+			clauses: exp.NewMergeClauses().SetInto(goqu.I("schema.table")),
+		},
+		mergeTestCase{
+			ds:      bd,
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")),
+		},
+	)
+
+	mds.PanicsWithValue(goqu.ErrUnsupportedMergeTargetType, func() {
+		goqu.Merge(true)
+	})
+}
+
+func (mds *mergeDatasetSuite) TestUsing() {
+	bd := goqu.Merge("items")
+	source := goqu.From("new_items").As("n")
+	mds.assertCases(
+		mergeTestCase{
+			ds:      bd.Using("new_items"),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).SetUsing(goqu.C("new_items")),
+		},
+		mergeTestCase{
+			ds:      bd.Using(goqu.T("new_items").As("n")),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).SetUsing(goqu.T("new_items").As("n")),
+		},
+		mergeTestCase{
+			ds:      bd.Using(source),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).SetUsing(source),
+		},
+		mergeTestCase{
+			ds:      bd,
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")),
+		},
+	)
+
+	mds.PanicsWithValue(goqu.ErrUnsupportedMergeSourceType, func() {
+		goqu.Merge("items").Using(true)
+	})
+}
+
+func (mds *mergeDatasetSuite) TestOn() {
+	bd := goqu.Merge("items")
+	mds.assertCases(
+		mergeTestCase{
+			ds: bd.On(goqu.I("items.id").Eq(goqu.I("n.id"))),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).
+				OnAppend(goqu.I("items.id").Eq(goqu.I("n.id"))),
+		},
+		mergeTestCase{
+			ds: bd.On(goqu.I("items.id").Eq(goqu.I("n.id"))).On(goqu.I("items.a").Eq(goqu.I("n.a"))),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).
+				OnAppend(goqu.I("items.id").Eq(goqu.I("n.id"))).
+				OnAppend(goqu.I("items.a").Eq(goqu.I("n.a"))),
+		},
+		mergeTestCase{
+			ds:      bd,
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
+func (mds *mergeDatasetSuite) TestWhenMatched() {
+	bd := goqu.Merge("items")
+	update := goqu.MergeUpdate(goqu.Record{"name": goqu.I("n.name")})
+	mds.assertCases(
+		mergeTestCase{
+			ds: bd.WhenMatched(update),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).
+				WhensAppend(exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, update)),
+		},
+		mergeTestCase{
+			ds: bd.WhenMatched(goqu.MergeDelete()).WhenMatched(update),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).
+				WhensAppend(exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, goqu.MergeDelete())).
+				WhensAppend(exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, update)),
+		},
+		mergeTestCase{
+			ds:      bd,
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
+func (mds *mergeDatasetSuite) TestWhenNotMatched() {
+	bd := goqu.Merge("items")
+	insert := goqu.MergeInsert(goqu.Record{"id": goqu.I("n.id")})
+	mds.assertCases(
+		mergeTestCase{
+			ds: bd.WhenNotMatched(insert),
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")).
+				WhensAppend(exp.NewMergeWhenExpression(exp.WhenNotMatchedMergeType, insert)),
+		},
+		mergeTestCase{
+			ds:      bd,
+			clauses: exp.NewMergeClauses().SetInto(goqu.C("items")),
+		},
+	)
+}
+
+func (mds *mergeDatasetSuite) TestToSQL() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Merge("test").SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToMergeSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Nil(err)
+	md.AssertExpectations(mds.T())
+}
+
+func (mds *mergeDatasetSuite) TestToSQL_Prepared() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Merge("test").Prepared(true).SetDialect(md)
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(true)
+	md.On("ToMergeSQL", sqlB, c).Return(nil).Once()
+
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Nil(err)
+	md.AssertExpectations(mds.T())
+}
+
+func (mds *mergeDatasetSuite) TestToSQL_WithError() {
+	md := new(mocks.SQLDialect)
+	ds := goqu.Merge("test").SetDialect(md)
+	c := ds.GetClauses()
+	ee := errors.New("expected error")
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToMergeSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(ee)
+	}).Once()
+
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(ee, err)
+	md.AssertExpectations(mds.T())
+}
+
+func (mds *mergeDatasetSuite) TestExecutor() {
+	mDB, _, err := sqlmock.New()
+	mds.NoError(err)
+
+	ds := goqu.New("mock", mDB).
+		Merge("items").
+		Using(goqu.T("new_items").As("n")).
+		On(goqu.I("items.id").Eq(goqu.I("n.id"))).
+		WhenMatched(goqu.MergeUpdate(goqu.Record{"count": 10}))
+
+	msql, args, err := ds.Executor().ToSQL()
+	mds.NoError(err)
+	mds.Empty(args)
+	mds.Equal(
+		`MERGE INTO "items" USING "new_items" AS "n" ON ("items"."id" = "n"."id") WHEN MATCHED THEN UPDATE SET "count"=10`,
+		msql,
+	)
+
+	msql, args, err = ds.Prepared(true).Executor().ToSQL()
+	mds.NoError(err)
+	mds.Equal([]interface{}{int64(10)}, args)
+	mds.Equal(
+		`MERGE INTO "items" USING "new_items" AS "n" ON ("items"."id" = "n"."id") WHEN MATCHED THEN UPDATE SET "count"=?`,
+		msql,
+	)
+}
+
+func (mds *mergeDatasetSuite) TestSetError() {
+	err1 := errors.New("error #1")
+	err2 := errors.New("error #2")
+	err3 := errors.New("error #3")
+
+	// Verify initial error set/get works properly
+	md := new(mocks.SQLDialect)
+	ds := goqu.Merge("test").SetDialect(md)
+	ds = ds.SetError(err1)
+	mds.Equal(err1, ds.Error())
+	sql, args, err := ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+
+	// Repeated SetError calls on Dataset should not overwrite the original error
+	ds = ds.SetError(err2)
+	mds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+
+	// Builder functions should not lose the error
+	ds = ds.Using("other")
+	mds.Equal(err1, ds.Error())
+	sql, args, err = ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+
+	// Deeper errors inside SQL generation should still return original error
+	c := ds.GetClauses()
+	sqlB := sb.NewSQLBuilder(false)
+	md.On("ToMergeSQL", sqlB, c).Run(func(args mock.Arguments) {
+		args.Get(0).(sb.SQLBuilder).SetError(err3)
+	}).Once()
+
+	sql, args, err = ds.ToSQL()
+	mds.Empty(sql)
+	mds.Empty(args)
+	mds.Equal(err1, err)
+}
+
+func TestMergeDataset(t *testing.T) {
+	suite.Run(t, new(mergeDatasetSuite))
+}
@@ -6,6 +6,7 @@ import exp "github.com/doug-martin/goqu/v9/exp"
 
 import mock "github.com/stretchr/testify/mock"
 import sb "github.com/doug-martin/goqu/v9/internal/sb"
+import sqlgen "github.com/doug-martin/goqu/v9/sqlgen"
 
 // SQLDialect is an autogenerated mock type for the SQLDialect type
 type SQLDialect struct {
@@ -26,6 +27,22 @@ func (_m *SQLDialect) Dialect() string {
 	return r0
 }
 
+// DialectOptions provides a mock function with given fields:
+func (_m *SQLDialect) DialectOptions() *sqlgen.SQLDialectOptions {
+	ret := _m.Called()
+
+	var r0 *sqlgen.SQLDialectOptions
+	if rf, ok := ret.Get(0).(func() *sqlgen.SQLDialectOptions); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlgen.SQLDialectOptions)
+		}
+	}
+
+	return r0
+}
+
 // ToDeleteSQL provides a mock function with given fields: b, clauses
 func (_m *SQLDialect) ToDeleteSQL(b sb.SQLBuilder, clauses exp.DeleteClauses) {
 	_m.Called(b, clauses)
@@ -36,6 +53,11 @@ func (_m *SQLDialect) ToInsertSQL(b sb.SQLBuilder, clauses exp.InsertClauses) {
 	_m.Called(b, clauses)
 }
 
+// ToMergeSQL provides a mock function with given fields: b, clauses
+func (_m *SQLDialect) ToMergeSQL(b sb.SQLBuilder, clauses exp.MergeClauses) {
+	_m.Called(b, clauses)
+}
+
 // ToSelectSQL provides a mock function with given fields: b, clauses
 func (_m *SQLDialect) ToSelectSQL(b sb.SQLBuilder, clauses exp.SelectClauses) {
 	_m.Called(b, clauses)
@@ -0,0 +1,493 @@
+package goqu
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/util"
+)
+
+var (
+	// Returned when a cursor passed to SelectDataset#Paginate cannot be decoded or does not match the ORDER BY
+	// columns of the dataset.
+	ErrInvalidPageCursor = errors.New("invalid page cursor")
+
+	errPaginateOrderRequired = errors.New(
+		"an ORDER BY clause is required to paginate, use Order to set the columns to paginate on",
+	)
+	errPageSizeRequired = errors.New("page size must be greater than 0")
+)
+
+func errPaginateOrderExpression(e exp.Expression) error {
+	return errors.New("unable to paginate on order expression %T, an identifier is required", e)
+}
+
+func errPaginateNullValue(col interface{}) error {
+	return errors.New(
+		"unable to paginate on NULL value of column %q, use NullsFirst or NullsLast to order NULL values", col,
+	)
+}
+
+func errPaginateUnsupportedValue(col, val interface{}) error {
+	return errors.New("unsupported value %T of column %q for a page cursor", val, col)
+}
+
+func errPaginateMissingColumn(col interface{}) error {
+	return errors.New("unable to find value of column %q in row for the page cursor", col)
+}
+
+type (
+	// Metadata about a page returned by SelectDataset#Page.
+	PageInfo struct {
+		// The number of the page starting at 1
+		Page uint `json:"page"`
+		// The maximum number of rows in a page
+		Size uint `json:"size"`
+		// The total number of rows in all pages
+		TotalCount int64 `json:"total_count"`
+		// The total number of pages
+		TotalPages int64 `json:"total_pages"`
+		// True if there is a page after this page
+		HasNext bool `json:"has_next"`
+		// True if there is a page before this page
+		HasPrev bool `json:"has_prev"`
+	}
+	// used internally to build the seek predicate for a single ORDER BY column
+	pageColumn struct {
+		col      exp.IdentifierExpression
+		isAsc    bool
+		nullSort exp.NullSortType
+	}
+	// used internally to encode a single value of a page cursor, the value is stored as a string so the type of the
+	// value (e.g. int64 vs float64) is preserved
+	pageCursorValue struct {
+		Type  string `json:"t"`
+		Value string `json:"v,omitempty"`
+	}
+)
+
+// The alias of the COUNT(*) OVER () column selected by SelectDataset#Page.
+const pageTotalCountColumn = "goqu_total_count"
+
+const (
+	pageCursorNull   = "n"
+	pageCursorBool   = "b"
+	pageCursorInt    = "i"
+	pageCursorUint   = "u"
+	pageCursorFloat  = "f"
+	pageCursorString = "s"
+	pageCursorBytes  = "x"
+	pageCursorTime   = "t"
+)
+
+// Keyset (seek) pagination. Limits the dataset to pageSize rows and adds a WHERE condition to select the rows after
+// the row the cursor was created from, see NextCursor. Unlike Offset the database can use an index to seek to the
+// first row of the page, so deep pages are as fast as the first page.
+//
+// The ORDER BY clause must be set before calling Paginate and must only contain identifiers. The columns should be
+// unique together (e.g. end with the primary key) otherwise rows may be skipped. If the dialect supports row value
+// comparisons and every column is sorted in the same direction the condition is a row value comparison (e.g.
+// ("a", "b") > (1, 2)), otherwise it is expanded into a chain of OR conditions. Columns ordered with NullsFirst or
+// NullsLast may contain NULL values.
+//
+// cursor: The cursor returned by NextCursor for the previous page, or an empty string for the first page.
+//
+// pageSize: The maximum number of rows in the page.
+//
+//	ds := db.From("items").Order(goqu.C("created").Desc(), goqu.C("id").Asc())
+//	var items []Item
+//	if err := ds.Paginate(cursor, 20).ScanStructs(&items); err != nil {
+//	    return err
+//	}
+//	next, err := ds.NextCursor(items[len(items)-1])
+func (sd *SelectDataset) Paginate(cursor string, pageSize uint) *SelectDataset {
+	ds := sd.Limit(pageSize)
+	cols, err := sd.pageColumns()
+	if err != nil {
+		return ds.SetError(err)
+	}
+	if cursor == "" {
+		return ds
+	}
+	vals, err := decodePageCursor(cursor)
+	if err != nil {
+		return ds.SetError(err)
+	}
+	if len(vals) != len(cols) {
+		return ds.SetError(ErrInvalidPageCursor)
+	}
+	seek, err := seekExpression(sd.dialect.DialectOptions(), cols, vals)
+	if err != nil {
+		return ds.SetError(err)
+	}
+	return ds.Where(seek)
+}
+
+// Creates the cursor for the page after lastRow, lastRow should be the last row scanned from the page. The values of
+// the ORDER BY columns are read from the row, the row may be a struct, a pointer to a struct or a map of column names
+// to values (e.g. Record). The returned cursor is an opaque url safe string that can be passed to Paginate.
+func (sd *SelectDataset) NextCursor(lastRow interface{}) (string, error) {
+	cols, err := sd.pageColumns()
+	if err != nil {
+		return "", err
+	}
+	vals, err := pageRowValues(lastRow, cols)
+	if err != nil {
+		return "", err
+	}
+	return encodePageCursor(cols, vals)
+}
+
+// Offset pagination. Selects the rows of the page into i and returns the total number of rows with the page metadata.
+// If the dialect supports window functions the total count is selected with COUNT(*) OVER () in the same query,
+// otherwise (or if the dataset uses DISTINCT or a compound statement) a second COUNT(*) query without the ORDER BY,
+// LIMIT and OFFSET of the dataset is executed.
+//
+// Page will only select the columns that can be scanned in to the struct unless you have explicitly selected certain
+// columns. See ScanStructsContext.
+//
+// page: The number of the page starting at 1, a 0 is treated as 1.
+//
+// size: The maximum number of rows in the page.
+//
+// i: A pointer to a slice of structs
+//
+//	var items []Item
+//	info, err := db.From("items").Order(goqu.C("id").Asc()).Page(ctx, 3, 20, &items)
+func (sd *SelectDataset) Page(ctx context.Context, page, size uint, i interface{}) (PageInfo, error) {
+	if sd.queryFactory == nil {
+		return PageInfo{}, ErrQueryFactoryNotFoundError
+	}
+	if size == 0 {
+		return PageInfo{}, errPageSizeRequired
+	}
+	if page == 0 {
+		page = 1
+	}
+	ds := sd
+	if sd.GetClauses().IsDefaultSelect() {
+		ds = sd.Select(i)
+	}
+	ds = ds.Limit(size).Offset((page - 1) * size)
+
+	var total int64
+	if sd.canCountOver() {
+		count, err := ds.SelectAppend(COUNT(Star()).Over(W()).As(pageTotalCountColumn)).
+			Executor().
+			ScanStructsAndCountContext(ctx, i, pageTotalCountColumn)
+		if err != nil {
+			return PageInfo{}, err
+		}
+		total = count
+		// no rows are returned if the page is after the last page so the count has to be selected separately
+		if total == 0 && page > 1 {
+			if total, err = sd.pageCount(ctx); err != nil {
+				return PageInfo{}, err
+			}
+		}
+	} else {
+		if err := ds.ScanStructsContext(ctx, i); err != nil {
+			return PageInfo{}, err
+		}
+		count, err := sd.pageCount(ctx)
+		if err != nil {
+			return PageInfo{}, err
+		}
+		total = count
+	}
+	return newPageInfo(page, size, total), nil
+}
+
+func newPageInfo(page, size uint, total int64) PageInfo {
+	totalPages := (total + int64(size) - 1) / int64(size)
+	return PageInfo{
+		Page:       page,
+		Size:       size,
+		TotalCount: total,
+		TotalPages: totalPages,
+		HasNext:    int64(page) < totalPages,
+		HasPrev:    page > 1,
+	}
+}
+
+// a window function is computed before DISTINCT and only applies to the first statement of a compound statement
+func (sd *SelectDataset) canCountOver() bool {
+	c := sd.GetClauses()
+	return sd.dialect.DialectOptions().SupportsWindowFunction && c.Distinct() == nil && len(c.Compounds()) == 0
+}
+
+// used internally to count the rows of all pages without the ORDER BY, LIMIT and OFFSET of the dataset
+func (sd *SelectDataset) pageCount(ctx context.Context) (int64, error) {
+	ds := sd.ClearOrder().ClearLimit().ClearOffset()
+	c := ds.GetClauses()
+	if c.Distinct() != nil || c.GroupBy() != nil || len(c.Compounds()) > 0 {
+		ds = ds.FromSelf()
+	}
+	return ds.CountContext(ctx)
+}
+
+// Generates the SELECT sql for the page of the dataset after cursor, scans the rows into a slice of T and returns the
+// cursor of the next page. The next cursor is empty if there are no more rows. See Paginate and ScanAll.
+//
+//	items, next, err := goqu.ScanPage[Item](ctx, db.From("items").Order(goqu.C("id").Asc()), cursor, 20)
+func ScanPage[T any](ctx context.Context, ds *SelectDataset, cursor string, pageSize uint) ([]T, string, error) {
+	if pageSize == 0 {
+		items, err := ScanAll[T](ctx, ds.Paginate(cursor, 0))
+		return items, "", err
+	}
+	// select an extra row to determine if there is a next page
+	items, err := ScanAll[T](ctx, ds.Paginate(cursor, pageSize+1))
+	if err != nil || uint(len(items)) <= pageSize {
+		return items, "", err
+	}
+	items = items[:pageSize]
+	next, err := ds.NextCursor(items[pageSize-1])
+	if err != nil {
+		return nil, "", err
+	}
+	return items, next, nil
+}
+
+// used internally to get the ORDER BY columns used to paginate the dataset
+func (sd *SelectDataset) pageColumns() ([]pageColumn, error) {
+	order := sd.clauses.Order()
+	if order == nil || order.IsEmpty() {
+		return nil, errPaginateOrderRequired
+	}
+	exps := order.Columns()
+	cols := make([]pageColumn, 0, len(exps))
+	for _, e := range exps {
+		oe, ok := e.(exp.OrderedExpression)
+		if !ok {
+			return nil, errPaginateOrderExpression(e)
+		}
+		col, ok := oe.SortExpression().(exp.IdentifierExpression)
+		if !ok {
+			return nil, errPaginateOrderExpression(oe.SortExpression())
+		}
+		cols = append(cols, pageColumn{col: col, isAsc: oe.IsAsc(), nullSort: oe.NullSortType()})
+	}
+	return cols, nil
+}
+
+// used internally to create the condition that selects the rows after vals
+func seekExpression(do *SQLDialectOptions, cols []pageColumn, vals []interface{}) (exp.Expression, error) {
+	if do.SupportsRowValueComparison && canCompareRowValues(cols, vals) {
+		idents := make([]interface{}, 0, len(cols))
+		for _, col := range cols {
+			idents = append(idents, col.col)
+		}
+		if cols[0].isAsc {
+			return L("(?) > ?", exp.NewColumnListExpression(idents...), vals), nil
+		}
+		return L("(?) < ?", exp.NewColumnListExpression(idents...), vals), nil
+	}
+	ors := make([]exp.Expression, 0, len(cols))
+	equals := make([]exp.Expression, 0, len(cols))
+	for i, col := range cols {
+		after, err := col.after(vals[i])
+		if err != nil {
+			return nil, err
+		}
+		if after != nil {
+			ands := make([]exp.Expression, 0, len(equals)+1)
+			ands = append(append(ands, equals...), after)
+			ors = append(ors, And(ands...))
+		}
+		equals = append(equals, col.equal(vals[i]))
+	}
+	if len(ors) == 0 {
+		// the cursor is the last row so no rows should be returned
+		return L("1 = 0"), nil
+	}
+	return Or(ors...), nil
+}
+
+// row values can only be compared if every column is sorted in the same direction without NULL values
+func canCompareRowValues(cols []pageColumn, vals []interface{}) bool {
+	for i, col := range cols {
+		if col.isAsc != cols[0].isAsc || col.nullSort != exp.NoNullsSortType || vals[i] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// returns the condition for rows that sort after val in this column, or nil if no rows sort after val
+func (pc pageColumn) after(val interface{}) (exp.Expression, error) {
+	nullsLast := pc.nullSort == exp.NullsLastSortType
+	if val == nil {
+		switch pc.nullSort {
+		case exp.NoNullsSortType:
+			return nil, errPaginateNullValue(pc.col.GetCol())
+		case exp.NullsFirstSortType:
+			return pc.col.IsNotNull(), nil
+		default:
+			return nil, nil
+		}
+	}
+	var after exp.Expression
+	if pc.isAsc {
+		after = pc.col.Gt(val)
+	} else {
+		after = pc.col.Lt(val)
+	}
+	if nullsLast {
+		return Or(after, pc.col.IsNull()), nil
+	}
+	return after, nil
+}
+
+// returns the condition for rows that have the same value as val in this column
+func (pc pageColumn) equal(val interface{}) exp.Expression {
+	if val == nil {
+		return pc.col.IsNull()
+	}
+	return pc.col.Eq(val)
+}
+
+// used internally to read the values of the page columns from a row
+func pageRowValues(row interface{}, cols []pageColumn) ([]interface{}, error) {
+	vals := make([]interface{}, 0, len(cols))
+	switch r := row.(type) {
+	case exp.Record:
+		return pageRowValues(map[string]interface{}(r), cols)
+	case map[string]interface{}:
+		for _, col := range cols {
+			val, ok := r[pageColumnName(col, r)]
+			if !ok {
+				return nil, errPaginateMissingColumn(col.col.GetCol())
+			}
+			vals = append(vals, val)
+		}
+		return vals, nil
+	}
+	cm, err := util.GetColumnMap(row)
+	if err != nil {
+		return nil, err
+	}
+	value := reflect.Indirect(reflect.ValueOf(row))
+	for _, col := range cols {
+		data, ok := cm[pageColumnName(col, cm)]
+		if !ok {
+			return nil, errPaginateMissingColumn(col.col.GetCol())
+		}
+		fieldValue, ok := util.SafeGetFieldByIndex(value, data.FieldIndex)
+		if !ok {
+			vals = append(vals, nil)
+			continue
+		}
+		vals = append(vals, fieldValue.Interface())
+	}
+	return vals, nil
+}
+
+// returns the table qualified name of the column if it is in the row (e.g. a joined struct), otherwise the column name
+func pageColumnName[V any](col pageColumn, row map[string]V) string {
+	name, _ := col.col.GetCol().(string)
+	if table := col.col.GetTable(); table != "" {
+		if _, ok := row[table+"."+name]; ok {
+			return table + "." + name
+		}
+	}
+	return name
+}
+
+func encodePageCursor(cols []pageColumn, vals []interface{}) (string, error) {
+	cvs := make([]pageCursorValue, 0, len(vals))
+	for i, val := range vals {
+		cv, err := newPageCursorValue(cols[i].col.GetCol(), val)
+		if err != nil {
+			return "", err
+		}
+		cvs = append(cvs, cv)
+	}
+	b, err := json.Marshal(cvs)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodePageCursor(cursor string) ([]interface{}, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidPageCursor
+	}
+	var cvs []pageCursorValue
+	if err = json.Unmarshal(b, &cvs); err != nil {
+		return nil, ErrInvalidPageCursor
+	}
+	vals := make([]interface{}, 0, len(cvs))
+	for _, cv := range cvs {
+		val, valErr := cv.value()
+		if valErr != nil {
+			return nil, ErrInvalidPageCursor
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
+
+func newPageCursorValue(col, val interface{}) (pageCursorValue, error) {
+	rv := reflect.ValueOf(val)
+	if !rv.IsValid() || (util.IsPointer(rv.Kind()) && rv.IsNil()) {
+		return pageCursorValue{Type: pageCursorNull}, nil
+	}
+	switch v := val.(type) {
+	case time.Time:
+		return pageCursorValue{Type: pageCursorTime, Value: v.Format(time.RFC3339Nano)}, nil
+	case []byte:
+		return pageCursorValue{Type: pageCursorBytes, Value: base64.StdEncoding.EncodeToString(v)}, nil
+	case driver.Valuer:
+		dv, err := v.Value()
+		if err != nil {
+			return pageCursorValue{}, err
+		}
+		return newPageCursorValue(col, dv)
+	}
+	switch kind := rv.Kind(); {
+	case util.IsPointer(kind):
+		return newPageCursorValue(col, rv.Elem().Interface())
+	case util.IsBool(kind):
+		return pageCursorValue{Type: pageCursorBool, Value: strconv.FormatBool(rv.Bool())}, nil
+	case util.IsInt(kind):
+		return pageCursorValue{Type: pageCursorInt, Value: strconv.FormatInt(rv.Int(), 10)}, nil
+	case util.IsUint(kind):
+		return pageCursorValue{Type: pageCursorUint, Value: strconv.FormatUint(rv.Uint(), 10)}, nil
+	case util.IsFloat(kind):
+		return pageCursorValue{Type: pageCursorFloat, Value: strconv.FormatFloat(rv.Float(), 'g', -1, 64)}, nil
+	case util.IsString(kind):
+		return pageCursorValue{Type: pageCursorString, Value: rv.String()}, nil
+	}
+	return pageCursorValue{}, errPaginateUnsupportedValue(col, val)
+}
+
+func (cv pageCursorValue) value() (interface{}, error) {
+	switch cv.Type {
+	case pageCursorNull:
+		return nil, nil
+	case pageCursorBool:
+		return strconv.ParseBool(cv.Value)
+	case pageCursorInt:
+		return strconv.ParseInt(cv.Value, 10, 64)
+	case pageCursorUint:
+		return strconv.ParseUint(cv.Value, 10, 64)
+	case pageCursorFloat:
+		return strconv.ParseFloat(cv.Value, 64)
+	case pageCursorString:
+		return cv.Value, nil
+	case pageCursorBytes:
+		return base64.StdEncoding.DecodeString(cv.Value)
+	case pageCursorTime:
+		return time.Parse(time.RFC3339Nano, cv.Value)
+	}
+	return nil, ErrInvalidPageCursor
+}
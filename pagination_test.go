@@ -0,0 +1,298 @@
+package goqu_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type paginationSuite struct {
+	suite.Suite
+}
+
+func (ps *paginationSuite) SetupSuite() {
+	noRowValues := goqu.DefaultDialectOptions()
+	noRowValues.SupportsRowValueComparison = false
+	goqu.RegisterDialect("no-row-values", noRowValues)
+	noWindowFunctions := goqu.DefaultDialectOptions()
+	noWindowFunctions.SupportsWindowFunction = false
+	goqu.RegisterDialect("no-window-functions", noWindowFunctions)
+}
+
+func (ps *paginationSuite) TearDownSuite() {
+	goqu.DeregisterDialect("no-row-values")
+	goqu.DeregisterDialect("no-window-functions")
+}
+
+func (ps *paginationSuite) assertPage(ds *goqu.SelectDataset, lastRow interface{}, expectedSQL string, expectedArgs ...interface{}) {
+	cursor, err := ds.NextCursor(lastRow)
+	ps.Require().NoError(err)
+	ps.NotEmpty(cursor)
+	sql, args, err := ds.Paginate(cursor, 10).Prepared(len(expectedArgs) > 0).ToSQL()
+	ps.Require().NoError(err)
+	ps.Equal(expectedSQL, sql)
+	if len(expectedArgs) > 0 {
+		ps.Equal(expectedArgs, args)
+	}
+}
+
+func (ps *paginationSuite) TestPaginate_FirstPage() {
+	sql, _, err := goqu.From("items").Order(goqu.C("id").Asc()).Paginate("", 10).ToSQL()
+	ps.NoError(err)
+	ps.Equal(`SELECT * FROM "items" ORDER BY "id" ASC LIMIT 10`, sql)
+}
+
+func (ps *paginationSuite) TestPaginate_RowValues() {
+	ds := goqu.From("items").Order(goqu.C("name").Asc(), goqu.C("id").Asc())
+	ps.assertPage(ds, goqu.Record{"name": "Test", "id": 10},
+		`SELECT * FROM "items" WHERE ("name", "id") > ('Test', 10) ORDER BY "name" ASC, "id" ASC LIMIT 10`)
+	ps.assertPage(ds, goqu.Record{"name": "Test", "id": 10},
+		`SELECT * FROM "items" WHERE ("name", "id") > (?, ?) ORDER BY "name" ASC, "id" ASC LIMIT ?`,
+		"Test", int64(10), int64(10))
+
+	ds = goqu.From("items").Order(goqu.C("name").Desc(), goqu.C("id").Desc())
+	ps.assertPage(ds, goqu.Record{"name": "Test", "id": 10},
+		`SELECT * FROM "items" WHERE ("name", "id") < ('Test', 10) ORDER BY "name" DESC, "id" DESC LIMIT 10`)
+}
+
+func (ps *paginationSuite) TestPaginate_ExpandedOr() {
+	// mixed directions can not use a row value comparison
+	ds := goqu.From("items").Order(goqu.C("created").Desc(), goqu.C("id").Asc())
+	created := time.Date(2021, 1, 2, 3, 4, 5, 6, time.UTC)
+	ps.assertPage(ds, goqu.Record{"created": created, "id": 10},
+		`SELECT * FROM "items" WHERE (("created" < ?) OR (("created" = ?) AND ("id" > ?))) `+
+			`ORDER BY "created" DESC, "id" ASC LIMIT ?`,
+		created, created, int64(10), int64(10))
+
+	// the dialect does not support row value comparisons
+	ds = goqu.Dialect("no-row-values").From("items").Order(goqu.C("name").Asc(), goqu.C("id").Asc())
+	ps.assertPage(ds, goqu.Record{"name": "Test", "id": 10},
+		`SELECT * FROM "items" WHERE (("name" > 'Test') OR (("name" = 'Test') AND ("id" > 10))) `+
+			`ORDER BY "name" ASC, "id" ASC LIMIT 10`)
+}
+
+func (ps *paginationSuite) TestPaginate_Nulls() {
+	ds := goqu.From("items").Order(goqu.C("name").Asc().NullsLast(), goqu.C("id").Asc())
+	ps.assertPage(ds, goqu.Record{"name": "Test", "id": 10},
+		`SELECT * FROM "items" WHERE ((("name" > 'Test') OR ("name" IS NULL)) OR (("name" = 'Test') AND ("id" > 10))) `+
+			`ORDER BY "name" ASC NULLS LAST, "id" ASC LIMIT 10`)
+	ps.assertPage(ds, goqu.Record{"name": nil, "id": 10},
+		`SELECT * FROM "items" WHERE (("name" IS NULL) AND ("id" > 10)) ORDER BY "name" ASC NULLS LAST, "id" ASC LIMIT 10`)
+
+	ds = goqu.From("items").Order(goqu.C("name").Desc().NullsFirst(), goqu.C("id").Asc())
+	ps.assertPage(ds, goqu.Record{"name": "Test", "id": 10},
+		`SELECT * FROM "items" WHERE (("name" < 'Test') OR (("name" = 'Test') AND ("id" > 10))) `+
+			`ORDER BY "name" DESC NULLS FIRST, "id" ASC LIMIT 10`)
+	ps.assertPage(ds, goqu.Record{"name": nil, "id": 10},
+		`SELECT * FROM "items" WHERE (("name" IS NOT NULL) OR (("name" IS NULL) AND ("id" > 10))) `+
+			`ORDER BY "name" DESC NULLS FIRST, "id" ASC LIMIT 10`)
+
+	// the cursor is the last possible row
+	ds = goqu.From("items").Order(goqu.C("name").Asc().NullsLast())
+	ps.assertPage(ds, goqu.Record{"name": nil},
+		`SELECT * FROM "items" WHERE 1 = 0 ORDER BY "name" ASC NULLS LAST LIMIT 10`)
+}
+
+func (ps *paginationSuite) TestNextCursor_Structs() {
+	type item struct {
+		ID      int64          `db:"id"`
+		Name    sql.NullString `db:"name"`
+		Price   *float64       `db:"price"`
+		Enabled bool           `db:"enabled"`
+		Data    []byte         `db:"data"`
+		Count   uint32         `db:"count"`
+	}
+	ds := goqu.From("items").Order(
+		goqu.C("name").Asc(),
+		goqu.C("price").Asc(),
+		goqu.C("enabled").Asc(),
+		goqu.C("data").Asc(),
+		goqu.C("count").Asc(),
+		goqu.T("items").Col("id").Asc(),
+	)
+	price := 1.5
+	row := item{
+		ID:      10,
+		Name:    sql.NullString{String: "Test", Valid: true},
+		Price:   &price,
+		Enabled: true,
+		Data:    []byte("data"),
+		Count:   2,
+	}
+	for _, r := range []interface{}{row, &row} {
+		cursor, err := ds.NextCursor(r)
+		ps.Require().NoError(err)
+		_, args, err := ds.Paginate(cursor, 10).Prepared(true).ToSQL()
+		ps.Require().NoError(err)
+		ps.Equal([]interface{}{"Test", 1.5, true, []byte("data"), int64(2), int64(10), int64(10)}, args)
+	}
+
+	ds = goqu.From("items").Order(goqu.C("name").Asc().NullsFirst(), goqu.C("price").Asc().NullsFirst())
+	row.Name = sql.NullString{}
+	row.Price = nil
+	ps.assertPage(ds, row,
+		`SELECT * FROM "items" WHERE (("name" IS NOT NULL) OR (("name" IS NULL) AND ("price" IS NOT NULL))) `+
+			`ORDER BY "name" ASC NULLS FIRST, "price" ASC NULLS FIRST LIMIT 10`)
+}
+
+func (ps *paginationSuite) TestPaginate_Errors() {
+	_, _, err := goqu.From("items").Paginate("", 10).ToSQL()
+	ps.EqualError(err, "goqu: an ORDER BY clause is required to paginate, use Order to set the columns to paginate on")
+
+	_, _, err = goqu.From("items").Order(goqu.L("random()").Asc()).Paginate("", 10).ToSQL()
+	ps.EqualError(err, "goqu: unable to paginate on order expression exp.literal, an identifier is required")
+
+	ds := goqu.From("items").Order(goqu.C("name").Asc(), goqu.C("id").Asc())
+	for _, cursor := range []string{"not a cursor", "W10", "W3sidCI6ImkiLCJ2IjoiYSJ9XQ"} {
+		_, _, err = ds.Paginate(cursor, 10).ToSQL()
+		ps.Equal(goqu.ErrInvalidPageCursor, err, cursor)
+	}
+
+	cursor, err := ds.NextCursor(goqu.Record{"name": nil, "id": 1})
+	ps.NoError(err)
+	_, _, err = ds.Paginate(cursor, 10).ToSQL()
+	ps.EqualError(err, `goqu: unable to paginate on NULL value of column "name", use NullsFirst or NullsLast to order NULL values`)
+
+	_, err = ds.NextCursor(goqu.Record{"id": 1})
+	ps.EqualError(err, `goqu: unable to find value of column "name" in row for the page cursor`)
+	_, err = ds.NextCursor(goqu.Record{"name": struct{}{}, "id": 1})
+	ps.EqualError(err, `goqu: unsupported value struct {} of column "name" for a page cursor`)
+	_, err = ds.NextCursor("name")
+	ps.EqualError(err, "goqu: cannot scan into this type: string")
+}
+
+func (ps *paginationSuite) TestScanPage() {
+	mDB, sqlMock, err := sqlmock.New()
+	ps.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items" ORDER BY "name" ASC LIMIT 3`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			FromCSVString("111 Test Addr,Test1\n211 Test Addr,Test2\n311 Test Addr,Test3"))
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items" WHERE \("name"\) > \('Test2'\) ORDER BY "name" ASC LIMIT 3`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).FromCSVString("311 Test Addr,Test3"))
+
+	ctx := context.Background()
+	ds := goqu.New("mock", mDB).From("items").Order(goqu.C("name").Asc())
+	items, next, err := goqu.ScanPage[dsTestActionItem](ctx, ds, "", 2)
+	ps.NoError(err)
+	ps.Equal([]dsTestActionItem{
+		{Address: "111 Test Addr", Name: "Test1"},
+		{Address: "211 Test Addr", Name: "Test2"},
+	}, items)
+	ps.NotEmpty(next)
+
+	items, next, err = goqu.ScanPage[dsTestActionItem](ctx, ds, next, 2)
+	ps.NoError(err)
+	ps.Equal([]dsTestActionItem{{Address: "311 Test Addr", Name: "Test3"}}, items)
+	ps.Empty(next)
+	ps.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (ps *paginationSuite) TestPage_WindowFunction() {
+	mDB, sqlMock, err := sqlmock.New()
+	ps.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name", COUNT\(\*\) OVER \(\) AS "goqu_total_count" FROM "items" ` +
+		`WHERE \("name" LIKE 'Test%'\) ORDER BY "name" ASC LIMIT 2 OFFSET 2`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name", "goqu_total_count"}).
+			FromCSVString("311 Test Addr,Test3,5\n411 Test Addr,Test4,5"))
+
+	var items []dsTestActionItem
+	ds := goqu.New("mock", mDB).From("items").Where(goqu.C("name").Like("Test%")).Order(goqu.C("name").Asc())
+	info, err := ds.Page(context.Background(), 2, 2, &items)
+	ps.NoError(err)
+	ps.Equal([]dsTestActionItem{
+		{Address: "311 Test Addr", Name: "Test3"},
+		{Address: "411 Test Addr", Name: "Test4"},
+	}, items)
+	ps.Equal(goqu.PageInfo{Page: 2, Size: 2, TotalCount: 5, TotalPages: 3, HasNext: true, HasPrev: true}, info)
+	ps.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (ps *paginationSuite) TestPage_WindowFunctionAfterLastPage() {
+	mDB, sqlMock, err := sqlmock.New()
+	ps.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name", COUNT\(\*\) OVER \(\) AS "goqu_total_count" FROM "items" ` +
+		`ORDER BY "name" ASC LIMIT 2 OFFSET 8`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name", "goqu_total_count"}))
+	sqlMock.ExpectQuery(`SELECT COUNT\(\*\) AS "count" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).FromCSVString("5"))
+
+	var items []dsTestActionItem
+	ds := goqu.New("mock", mDB).From("items").Order(goqu.C("name").Asc())
+	info, err := ds.Page(context.Background(), 5, 2, &items)
+	ps.NoError(err)
+	ps.Empty(items)
+	ps.Equal(goqu.PageInfo{Page: 5, Size: 2, TotalCount: 5, TotalPages: 3, HasNext: false, HasPrev: true}, info)
+	ps.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (ps *paginationSuite) TestPage_CountQuery() {
+	mDB, sqlMock, err := sqlmock.New()
+	ps.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items" ORDER BY "name" ASC LIMIT 2`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			FromCSVString("111 Test Addr,Test1\n211 Test Addr,Test2"))
+	sqlMock.ExpectQuery(`SELECT COUNT\(\*\) AS "count" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).FromCSVString("4"))
+
+	var items []dsTestActionItem
+	ds := goqu.New("no-window-functions", mDB).From("items").Order(goqu.C("name").Asc())
+	info, err := ds.Page(context.Background(), 0, 2, &items)
+	ps.NoError(err)
+	ps.Len(items, 2)
+	ps.Equal(goqu.PageInfo{Page: 1, Size: 2, TotalCount: 4, TotalPages: 2, HasNext: true, HasPrev: false}, info)
+	ps.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (ps *paginationSuite) TestPage_CountQueryFromSelf() {
+	mDB, sqlMock, err := sqlmock.New()
+	ps.NoError(err)
+	sqlMock.ExpectQuery(`SELECT DISTINCT "name" FROM "items" ORDER BY "name" ASC LIMIT 2`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1\nTest2"))
+	sqlMock.ExpectQuery(`SELECT COUNT\(\*\) AS "count" FROM \(SELECT DISTINCT "name" FROM "items"\) AS "t1" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).FromCSVString("2"))
+
+	var items []dsTestActionItem
+	// a window function can not be used with DISTINCT
+	ds := goqu.New("mock", mDB).From("items").Select("name").Distinct().Order(goqu.C("name").Asc())
+	info, err := ds.Page(context.Background(), 1, 2, &items)
+	ps.NoError(err)
+	ps.Len(items, 2)
+	ps.Equal(goqu.PageInfo{Page: 1, Size: 2, TotalCount: 2, TotalPages: 1, HasNext: false, HasPrev: false}, info)
+	ps.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (ps *paginationSuite) TestPage_Errors() {
+	var items []dsTestActionItem
+	_, err := goqu.From("items").Page(context.Background(), 1, 10, &items)
+	ps.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+
+	mDB, sqlMock, err := sqlmock.New()
+	ps.NoError(err)
+	ds := goqu.New("mock", mDB).From("items")
+	_, err = ds.Page(context.Background(), 1, 0, &items)
+	ps.EqualError(err, "goqu: page size must be greater than 0")
+
+	sqlMock.ExpectQuery(`SELECT "address", "name", COUNT\(\*\) OVER \(\) AS "goqu_total_count" FROM "items" LIMIT 10`).
+		WillReturnError(sql.ErrConnDone)
+	_, err = ds.Page(context.Background(), 1, 10, &items)
+	ps.Equal(sql.ErrConnDone, err)
+	ps.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func TestPaginationSuite(t *testing.T) {
+	suite.Run(t, new(paginationSuite))
+}
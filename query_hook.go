@@ -0,0 +1,65 @@
+package goqu
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type (
+	// A hook that is called around every statement executed by a Database or TxDatabase. This can be used to add
+	// tracing, metrics or slow query logging. See Database#AddQueryHook.
+	//
+	// op: The operation being executed (e.g. EXEC, QUERY, QUERY ROW or PREPARE). When the statement is executed by a
+	// dataset the type of dataset (e.g. SELECT, INSERT) can be retrieved using exec.OperationFromContext.
+	//
+	// The query and args passed to the hook have every sensitive value replaced with exp.RedactedValue, see Sensitive.
+	QueryHook interface {
+		// Called before the statement is executed. The returned context is used to execute the statement and is passed
+		// to AfterQuery.
+		BeforeQuery(ctx context.Context, op, query string, args []interface{}) context.Context
+		// Called after the statement has been executed with the error returned from the driver and the time it took to
+		// execute the statement. For QUERY the duration does not include reading the rows.
+		AfterQuery(ctx context.Context, op, query string, args []interface{}, err error, duration time.Duration)
+	}
+	// An optional extension of QueryHook for hooks that need the result of the statement (e.g. the number of rows
+	// affected). If a hook implements QueryResultHook AfterQueryResult is called instead of AfterQuery.
+	QueryResultHook interface {
+		QueryHook
+		// Called after the statement has been executed like AfterQuery. The result is only set for EXEC.
+		AfterQueryResult(
+			ctx context.Context,
+			op, query string,
+			args []interface{},
+			result sql.Result,
+			err error,
+			duration time.Duration,
+		)
+	}
+	queryHooks []QueryHook
+)
+
+// used internally to call BeforeQuery on each hook. The returned func calls AfterQuery on each hook in reverse order.
+func (qh queryHooks) run(
+	ctx context.Context,
+	op, query string,
+	args []interface{},
+) (hookCtx context.Context, after func(result sql.Result, err error)) {
+	if len(qh) == 0 {
+		return ctx, func(sql.Result, error) {}
+	}
+	for _, hook := range qh {
+		ctx = hook.BeforeQuery(ctx, op, query, args)
+	}
+	start := time.Now()
+	return ctx, func(result sql.Result, err error) {
+		duration := time.Since(start)
+		for i := len(qh) - 1; i >= 0; i-- {
+			if rh, ok := qh[i].(QueryResultHook); ok {
+				rh.AfterQueryResult(ctx, op, query, args, result, err, duration)
+				continue
+			}
+			qh[i].AfterQuery(ctx, op, query, args, err, duration)
+		}
+	}
+}
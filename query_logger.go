@@ -0,0 +1,106 @@
+package goqu
+
+import (
+	"context"
+	"time"
+)
+
+// The level of a structured log entry. The values match the levels used by log/slog.
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = -4
+	LogLevelInfo  LogLevel = 0
+	LogLevelWarn  LogLevel = 4
+	LogLevelError LogLevel = 8
+)
+
+type (
+	// Interface for a structured logger. The args are alternating key value pairs in the same format as
+	// slog.Logger#Log, see NewSlogLogger to use a *slog.Logger.
+	StructuredLogger interface {
+		Log(ctx context.Context, level LogLevel, msg string, args ...interface{})
+	}
+	// Options used when logging queries with a StructuredLogger. See DefaultStructuredLoggerOptions.
+	StructuredLoggerOptions struct {
+		// The level to log statements at (DEFAULT=LogLevelDebug)
+		Level LogLevel
+		// The level to log statements at that take at least SlowThreshold to execute (DEFAULT=LogLevelWarn)
+		SlowLevel LogLevel
+		// The duration after which a statement is logged at the SlowLevel. A zero value disables slow query logging
+		// (DEFAULT=0)
+		SlowThreshold time.Duration
+		// The level to log statements at that return an error (DEFAULT=LogLevelError)
+		ErrorLevel LogLevel
+		// Used to redact the args before they are logged, if nil the args are logged as is. Returning nil will omit
+		// the args from the log entry (DEFAULT=nil)
+		RedactArgs func(query string, args []interface{}) []interface{}
+	}
+	// used internally to log each statement executed to a StructuredLogger
+	queryLogger struct {
+		logger StructuredLogger
+		opts   StructuredLoggerOptions
+	}
+)
+
+// Returns the default options used when logging queries with a StructuredLogger.
+func DefaultStructuredLoggerOptions() StructuredLoggerOptions {
+	return StructuredLoggerOptions{
+		Level:      LogLevelDebug,
+		SlowLevel:  LogLevelWarn,
+		ErrorLevel: LogLevelError,
+	}
+}
+
+func newQueryLogger(logger StructuredLogger, opts StructuredLoggerOptions) *queryLogger {
+	return &queryLogger{logger: logger, opts: opts}
+}
+
+// used internally to replace any queryLogger in the hooks with the logger. If logger is nil the queryLogger is removed.
+func (qh queryHooks) withQueryLogger(logger StructuredLogger, opts StructuredLoggerOptions) queryHooks {
+	hooks := make(queryHooks, 0, len(qh)+1)
+	for _, hook := range qh {
+		if _, ok := hook.(*queryLogger); !ok {
+			hooks = append(hooks, hook)
+		}
+	}
+	if logger != nil {
+		hooks = append(hooks, newQueryLogger(logger, opts))
+	}
+	return hooks
+}
+
+func (ql *queryLogger) BeforeQuery(ctx context.Context, op, query string, args []interface{}) context.Context {
+	return ctx
+}
+
+func (ql *queryLogger) AfterQuery(
+	ctx context.Context,
+	op, query string,
+	args []interface{},
+	err error,
+	duration time.Duration,
+) {
+	level, msg := ql.opts.Level, "goqu query"
+	switch {
+	case err != nil:
+		level = ql.opts.ErrorLevel
+	case ql.opts.SlowThreshold > 0 && duration >= ql.opts.SlowThreshold:
+		level, msg = ql.opts.SlowLevel, "goqu slow query"
+	}
+	attrs := []interface{}{"op", op, "sql", query}
+	if ql.opts.RedactArgs != nil {
+		args = ql.opts.RedactArgs(query, args)
+	}
+	if len(args) > 0 {
+		attrs = append(attrs, "args", args)
+	}
+	attrs = append(attrs, "duration", duration)
+	if err != nil {
+		attrs = append(attrs, "error", err)
+	}
+	if txID, ok := TxIDFromContext(ctx); ok {
+		attrs = append(attrs, "tx_id", txID)
+	}
+	ql.logger.Log(ctx, level, msg, attrs...)
+}
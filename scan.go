@@ -0,0 +1,101 @@
+package goqu
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/doug-martin/goqu/v9/exec"
+	"github.com/doug-martin/goqu/v9/internal/util"
+)
+
+// Generates the SELECT sql for the dataset and scans every row into a slice of T. If T is a struct (or a pointer
+// to a struct) only the columns that can be scanned into the struct will be selected unless you have explicitly
+// selected certain columns. Otherwise each row is scanned as a single primitive value.
+//
+//	items, err := goqu.ScanAll[Item](ctx, db.From("items"))
+func ScanAll[T any](ctx context.Context, ds *SelectDataset) ([]T, error) {
+	if ds.queryFactory == nil {
+		return nil, ErrQueryFactoryNotFoundError
+	}
+	return exec.ScanAll[T](ctx, selectForType[T](ds).Executor())
+}
+
+// Generates the SELECT sql for the dataset with a LIMIT of 1 and scans the first row into a T. This method returns
+// false if no record was found. See ScanAll for how columns are selected.
+//
+//	item, found, err := goqu.ScanOne[Item](ctx, db.From("items").Where(goqu.C("id").Eq(1)))
+func ScanOne[T any](ctx context.Context, ds *SelectDataset) (T, bool, error) {
+	if ds.queryFactory == nil {
+		var zero T
+		return zero, false, ErrQueryFactoryNotFoundError
+	}
+	return exec.ScanOne[T](ctx, selectForType[T](ds).Limit(1).Executor())
+}
+
+// Generates the SELECT sql for the dataset and returns a typed iterator over the results. The caller is responsible
+// for closing the returned Rows. See ScanAll for how columns are selected.
+//
+//	rows, err := goqu.QueryRows[Item](ctx, db.From("items"))
+//	if err != nil {
+//	    panic(err.Error())
+//	}
+//	defer rows.Close()
+//	for rows.Next() {
+//	    item, err := rows.Scan()
+//	    ...
+//	}
+func QueryRows[T any](ctx context.Context, ds *SelectDataset) (*exec.Rows[T], error) {
+	if ds.queryFactory == nil {
+		return nil, ErrQueryFactoryNotFoundError
+	}
+	return exec.QueryRows[T](ctx, selectForType[T](ds).Executor())
+}
+
+// Generates the SELECT sql for the dataset and calls fn with each row as it is scanned into a T. Unlike ScanAll the
+// rows are not collected into a slice, so only one row is held in memory at a time. If fn returns an error iteration
+// stops, the rows are closed and the error is returned. See ScanAll for how columns are selected.
+//
+//	err := goqu.Each(ctx, db.From("items"), func(item Item) error {
+//	    return enc.Encode(item)
+//	})
+func Each[T any](ctx context.Context, ds *SelectDataset, fn func(row T) error) error {
+	if ds.queryFactory == nil {
+		return ErrQueryFactoryNotFoundError
+	}
+	return exec.Each[T](ctx, selectForType[T](ds).Executor(), fn)
+}
+
+// Generates the SELECT sql for the dataset and returns an iterator that yields each row as it is scanned into a T,
+// only one row is held in memory at a time. If the query or a scan fails the error is yielded and iteration stops.
+// The rows are closed when iteration completes or the caller stops iterating early. See ScanAll for how columns are
+// selected.
+//
+// With go1.23 or later the iterator can be used in a range statement.
+//
+//	for item, err := range goqu.Stream[Item](ctx, db.From("items")) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    ...
+//	}
+func Stream[T any](ctx context.Context, ds *SelectDataset) func(yield func(row T, err error) bool) {
+	if ds.queryFactory == nil {
+		return func(yield func(row T, err error) bool) {
+			var zero T
+			yield(zero, ErrQueryFactoryNotFoundError)
+		}
+	}
+	return exec.Stream[T](ctx, selectForType[T](ds).Executor())
+}
+
+// used internally to select the columns of T when scanning into structs and no columns have been selected
+func selectForType[T any](ds *SelectDataset) *SelectDataset {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if !ds.GetClauses().IsDefaultSelect() || !util.IsStructScanType(t) {
+		return ds
+	}
+	if util.IsPointer(t.Kind()) {
+		t = t.Elem()
+	}
+	return ds.Select(reflect.New(t).Interface())
+}
@@ -0,0 +1,199 @@
+package goqu_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type scanSuite struct {
+	suite.Suite
+}
+
+func (ss *scanSuite) TestScanAll() {
+	mDB, sqlMock, err := sqlmock.New()
+	ss.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			FromCSVString("111 Test Addr,Test1\n211 Test Addr,Test2"))
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			FromCSVString("111 Test Addr,Test1\n211 Test Addr,Test2"))
+	sqlMock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1\nTest2"))
+	sqlMock.ExpectQuery(`SELECT "test" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"test"}).FromCSVString("test1\ntest2"))
+
+	ctx := context.Background()
+	db := goqu.New("mock", mDB)
+	items, err := goqu.ScanAll[dsTestActionItem](ctx, db.From("items"))
+	ss.NoError(err)
+	ss.Equal([]dsTestActionItem{
+		{Address: "111 Test Addr", Name: "Test1"},
+		{Address: "211 Test Addr", Name: "Test2"},
+	}, items)
+
+	itemPtrs, err := goqu.ScanAll[*dsTestActionItem](ctx, db.From("items"))
+	ss.NoError(err)
+	ss.Equal([]*dsTestActionItem{
+		{Address: "111 Test Addr", Name: "Test1"},
+		{Address: "211 Test Addr", Name: "Test2"},
+	}, itemPtrs)
+
+	names, err := goqu.ScanAll[string](ctx, db.From("items").Select("name"))
+	ss.NoError(err)
+	ss.Equal([]string{"Test1", "Test2"}, names)
+
+	items, err = goqu.ScanAll[dsTestActionItem](ctx, db.From("items").Select("test"))
+	ss.EqualError(err, `goqu: unable to find corresponding field to column "test" returned by query`)
+	ss.Nil(items)
+
+	items, err = goqu.ScanAll[dsTestActionItem](ctx, goqu.From("items"))
+	ss.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+	ss.Nil(items)
+}
+
+func (ss *scanSuite) TestScanOne() {
+	mDB, sqlMock, err := sqlmock.New()
+	ss.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).FromCSVString("111 Test Addr,Test1"))
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}))
+	sqlMock.ExpectQuery(`SELECT "name" FROM "items" LIMIT 1`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1"))
+
+	ctx := context.Background()
+	db := goqu.New("mock", mDB)
+	item, found, err := goqu.ScanOne[dsTestActionItem](ctx, db.From("items"))
+	ss.NoError(err)
+	ss.True(found)
+	ss.Equal(dsTestActionItem{Address: "111 Test Addr", Name: "Test1"}, item)
+
+	item, found, err = goqu.ScanOne[dsTestActionItem](ctx, db.From("items"))
+	ss.NoError(err)
+	ss.False(found)
+	ss.Equal(dsTestActionItem{}, item)
+
+	name, found, err := goqu.ScanOne[string](ctx, db.From("items").Select("name"))
+	ss.NoError(err)
+	ss.True(found)
+	ss.Equal("Test1", name)
+
+	_, found, err = goqu.ScanOne[dsTestActionItem](ctx, goqu.From("items"))
+	ss.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+	ss.False(found)
+}
+
+func (ss *scanSuite) TestQueryRows() {
+	mDB, sqlMock, err := sqlmock.New()
+	ss.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			FromCSVString("111 Test Addr,Test1\n211 Test Addr,Test2"))
+
+	ctx := context.Background()
+	db := goqu.New("mock", mDB)
+	rows, err := goqu.QueryRows[dsTestActionItem](ctx, db.From("items"))
+	ss.NoError(err)
+	var items []dsTestActionItem
+	for rows.Next() {
+		item, err := rows.Scan()
+		ss.NoError(err)
+		items = append(items, item)
+	}
+	ss.NoError(rows.Err())
+	ss.NoError(rows.Close())
+	ss.Equal([]dsTestActionItem{
+		{Address: "111 Test Addr", Name: "Test1"},
+		{Address: "211 Test Addr", Name: "Test2"},
+	}, items)
+
+	_, err = goqu.QueryRows[dsTestActionItem](ctx, goqu.From("items"))
+	ss.Equal(goqu.ErrQueryFactoryNotFoundError, err)
+}
+
+func (ss *scanSuite) TestEach() {
+	mDB, sqlMock, err := sqlmock.New()
+	ss.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			FromCSVString("111 Test Addr,Test1\n211 Test Addr,Test2")).
+		RowsWillBeClosed()
+	sqlMock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1\nTest2")).
+		RowsWillBeClosed()
+
+	ctx := context.Background()
+	db := goqu.New("mock", mDB)
+	var items []dsTestActionItem
+	ss.NoError(goqu.Each(ctx, db.From("items"), func(item dsTestActionItem) error {
+		items = append(items, item)
+		return nil
+	}))
+	ss.Equal([]dsTestActionItem{
+		{Address: "111 Test Addr", Name: "Test1"},
+		{Address: "211 Test Addr", Name: "Test2"},
+	}, items)
+
+	var names []string
+	ss.EqualError(goqu.Each(ctx, db.From("items").Select("name"), func(name string) error {
+		names = append(names, name)
+		return errors.New("stop")
+	}), "stop")
+	ss.Equal([]string{"Test1"}, names)
+
+	ss.Equal(goqu.ErrQueryFactoryNotFoundError, goqu.Each(ctx, goqu.From("items"), func(item dsTestActionItem) error {
+		return nil
+	}))
+	ss.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func (ss *scanSuite) TestStream() {
+	mDB, sqlMock, err := sqlmock.New()
+	ss.NoError(err)
+	sqlMock.ExpectQuery(`SELECT "address", "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"address", "name"}).
+			FromCSVString("111 Test Addr,Test1\n211 Test Addr,Test2")).
+		RowsWillBeClosed()
+
+	ctx := context.Background()
+	db := goqu.New("mock", mDB)
+	var items []*dsTestActionItem
+	goqu.Stream[*dsTestActionItem](ctx, db.From("items"))(func(item *dsTestActionItem, err error) bool {
+		ss.NoError(err)
+		items = append(items, item)
+		return true
+	})
+	ss.Equal([]*dsTestActionItem{
+		{Address: "111 Test Addr", Name: "Test1"},
+		{Address: "211 Test Addr", Name: "Test2"},
+	}, items)
+
+	var errs []error
+	goqu.Stream[dsTestActionItem](ctx, goqu.From("items"))(func(item dsTestActionItem, err error) bool {
+		errs = append(errs, err)
+		return true
+	})
+	ss.Equal([]error{goqu.ErrQueryFactoryNotFoundError}, errs)
+	ss.NoError(sqlMock.ExpectationsWereMet())
+}
+
+func TestScanSuite(t *testing.T) {
+	suite.Run(t, new(scanSuite))
+}
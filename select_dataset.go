@@ -15,6 +15,7 @@ type SelectDataset struct {
 	dialect      SQLDialect
 	clauses      exp.SelectClauses
 	isPrepared   prepared
+	scanMode     ScanMode
 	queryFactory exec.QueryFactory
 	err          error
 }
@@ -60,6 +61,17 @@ func (sd *SelectDataset) IsPrepared() bool {
 	return sd.isPrepared.Bool()
 }
 
+// Sets the behavior when the columns returned by the query do not match the fields of the struct being scanned into.
+// By default the mode set with SetDefaultScanMode is used. See ScanMode.
+//
+//	var items []Item
+//	err := db.From("items").Select(goqu.T("items").All()).ScanMode(goqu.ScanModeIgnoreUnknownColumns).ScanStructs(&items)
+func (sd *SelectDataset) ScanMode(mode ScanMode) *SelectDataset {
+	ret := sd.copy(sd.clauses)
+	ret.scanMode = mode
+	return ret
+}
+
 // Returns the current adapter on the dataset
 func (sd *SelectDataset) Dialect() SQLDialect {
 	return sd.dialect
@@ -92,6 +104,7 @@ func (sd *SelectDataset) copy(clauses exp.SelectClauses) *SelectDataset {
 		dialect:      sd.dialect,
 		clauses:      clauses,
 		isPrepared:   sd.isPrepared,
+		scanMode:     sd.scanMode,
 		queryFactory: sd.queryFactory,
 		err:          sd.err,
 	}
@@ -480,6 +493,20 @@ func (sd *SelectDataset) IntersectAll(other *SelectDataset) *SelectDataset {
 	return sd.withCompound(exp.IntersectAllCompoundType, other.CompoundFromSelf())
 }
 
+// Creates an EXCEPT statement with another dataset.
+// If this or the other dataset has a limit or offset it will use that dataset as a subselect in the FROM clause.
+// See examples.
+func (sd *SelectDataset) Except(other *SelectDataset) *SelectDataset {
+	return sd.withCompound(exp.ExceptCompoundType, other.CompoundFromSelf())
+}
+
+// Creates an EXCEPT ALL statement with another dataset.
+// If this or the other dataset has a limit or offset it will use that dataset as a subselect in the FROM clause.
+// See examples.
+func (sd *SelectDataset) ExceptAll(other *SelectDataset) *SelectDataset {
+	return sd.withCompound(exp.ExceptAllCompoundType, other.CompoundFromSelf())
+}
+
 func (sd *SelectDataset) withCompound(ct exp.CompoundType, other exp.AppendableExpression) *SelectDataset {
 	ce := exp.NewCompoundExpression(ct, other)
 	ret := sd.CompoundFromSelf()
@@ -552,7 +579,9 @@ func (sd *SelectDataset) ToSQL() (sql string, params []interface{}, err error) {
 //
 // See Dataset#ToUpdateSQL for arguments
 func (sd *SelectDataset) Executor() exec.QueryExecutor {
-	return sd.queryFactory.FromSQLBuilder(sd.selectSQLBuilder())
+	return sd.queryFactory.FromSQLBuilder(sd.selectSQLBuilder()).WithOperation(exec.OperationSelect).
+		WithColumnConverter(sd.dialect.DialectOptions().ConvertColumnValue).
+		WithScanMode(sd.scanMode)
 }
 
 // Appends this Dataset's SELECT statement to the SQLBuilder
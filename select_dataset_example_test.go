@@ -330,6 +330,50 @@ func ExampleSelectDataset_IntersectAll() {
 	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" INTERSECT ALL (SELECT * FROM (SELECT * FROM "test2" ORDER BY "id" DESC) AS "t1")
 }
 
+func ExampleSelectDataset_Except() {
+	sql, _, _ := goqu.From("test").
+		Except(goqu.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = goqu.From("test").
+		Limit(1).
+		Except(goqu.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = goqu.From("test").
+		Limit(1).
+		Except(goqu.From("test2").
+			Order(goqu.C("id").Desc())).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "test" EXCEPT (SELECT * FROM "test2")
+	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" EXCEPT (SELECT * FROM "test2")
+	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" EXCEPT (SELECT * FROM (SELECT * FROM "test2" ORDER BY "id" DESC) AS "t1")
+}
+
+func ExampleSelectDataset_ExceptAll() {
+	sql, _, _ := goqu.From("test").
+		ExceptAll(goqu.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = goqu.From("test").
+		Limit(1).
+		ExceptAll(goqu.From("test2")).
+		ToSQL()
+	fmt.Println(sql)
+	sql, _, _ = goqu.From("test").
+		Limit(1).
+		ExceptAll(goqu.From("test2").
+			Order(goqu.C("id").Desc())).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "test" EXCEPT ALL (SELECT * FROM "test2")
+	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" EXCEPT ALL (SELECT * FROM "test2")
+	// SELECT * FROM (SELECT * FROM "test" LIMIT 1) AS "t1" EXCEPT ALL (SELECT * FROM (SELECT * FROM "test2" ORDER BY "id" DESC) AS "t1")
+}
+
 func ExampleSelectDataset_ClearOffset() {
 	ds := goqu.From("test").
 		Offset(2)
@@ -349,6 +393,24 @@ func ExampleSelectDataset_Offset() {
 	// SELECT * FROM "test" OFFSET 2
 }
 
+func ExampleSelectDataset_Paginate() {
+	ds := goqu.From("test").Order(goqu.C("name").Asc(), goqu.C("id").Asc())
+	sql, _, _ := ds.Paginate("", 10).ToSQL()
+	fmt.Println(sql)
+
+	// the cursor is created from the last row of the previous page
+	cursor, _ := ds.NextCursor(goqu.Record{"name": "Bob", "id": 10})
+	sql, _, _ = ds.Paginate(cursor, 10).ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = ds.Order(goqu.C("name").Desc().NullsLast(), goqu.C("id").Asc()).Paginate(cursor, 10).ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT * FROM "test" ORDER BY "name" ASC, "id" ASC LIMIT 10
+	// SELECT * FROM "test" WHERE ("name", "id") > ('Bob', 10) ORDER BY "name" ASC, "id" ASC LIMIT 10
+	// SELECT * FROM "test" WHERE ((("name" < 'Bob') OR ("name" IS NULL)) OR (("name" = 'Bob') AND ("id" > 10))) ORDER BY "name" DESC NULLS LAST, "id" ASC LIMIT 10
+}
+
 func ExampleSelectDataset_Limit() {
 	ds := goqu.From("test").Limit(10)
 	sql, _, _ := ds.ToSQL()
@@ -423,6 +485,30 @@ func ExampleSelectDataset_GroupBy() {
 	// SELECT SUM("income") AS "income_sum" FROM "test" GROUP BY "age"
 }
 
+func ExampleSelectDataset_GroupBy_groupingSets() {
+	sql, _, _ := goqu.From("sales").
+		Select("region", "product", goqu.SUM("amount").As("total"), goqu.GROUPING("region", "product")).
+		GroupBy(goqu.Rollup("region", "product")).
+		ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = goqu.From("sales").
+		Select("region", "product", goqu.SUM("amount").As("total")).
+		GroupBy(goqu.Cube("region", "product")).
+		ToSQL()
+	fmt.Println(sql)
+
+	sql, _, _ = goqu.From("sales").
+		Select("region", "product", goqu.SUM("amount").As("total")).
+		GroupBy(goqu.GroupingSets([]string{"region", "product"}, "region", []string{})).
+		ToSQL()
+	fmt.Println(sql)
+	// Output:
+	// SELECT "region", "product", SUM("amount") AS "total", GROUPING("region", "product") FROM "sales" GROUP BY ROLLUP("region", "product")
+	// SELECT "region", "product", SUM("amount") AS "total" FROM "sales" GROUP BY CUBE("region", "product")
+	// SELECT "region", "product", SUM("amount") AS "total" FROM "sales" GROUP BY GROUPING SETS(("region", "product"), "region", ())
+}
+
 func ExampleSelectDataset_GroupByAppend() {
 	ds := goqu.From("test").
 		Select(goqu.SUM("income").As("income_sum")).
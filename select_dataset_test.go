@@ -1125,6 +1125,38 @@ func (sds *selectDatasetSuite) TestIntersectAll() {
 	)
 }
 
+func (sds *selectDatasetSuite) TestExcept() {
+	uds := goqu.From("union_test")
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.Except(uds),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				CompoundsAppend(exp.NewCompoundExpression(exp.ExceptCompoundType, uds)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
+func (sds *selectDatasetSuite) TestExceptAll() {
+	uds := goqu.From("union_test")
+	bd := goqu.From("test")
+	sds.assertCases(
+		selectTestCase{
+			ds: bd.ExceptAll(uds),
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")).
+				CompoundsAppend(exp.NewCompoundExpression(exp.ExceptAllCompoundType, uds)),
+		},
+		selectTestCase{
+			ds:      bd,
+			clauses: exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test")),
+		},
+	)
+}
+
 func (sds *selectDatasetSuite) TestAs() {
 	bd := goqu.From("test")
 	sds.assertCases(
@@ -1242,6 +1274,42 @@ func (sds *selectDatasetSuite) TestScanStructs() {
 	sds.Equal(goqu.ErrQueryFactoryNotFoundError, goqu.From("items").ScanStructs(items))
 }
 
+func (sds *selectDatasetSuite) TestScanStructs_ScanMode() {
+	mDB, sqlMock, err := sqlmock.New()
+	sds.NoError(err)
+	for i := 0; i < 4; i++ {
+		sqlMock.ExpectQuery(`SELECT "items"\.\* FROM "items"`).
+			WithArgs().
+			WillReturnRows(sqlmock.NewRows([]string{"address", "name", "created"}).
+				FromCSVString("111 Test Addr,Test1,2020-01-01"))
+	}
+	sqlMock.ExpectQuery(`SELECT "name" FROM "items"`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Test1"))
+
+	db := goqu.New("mock", mDB)
+	ds := db.From("items").Select(goqu.T("items").All())
+	var items []dsTestActionItem
+	sds.EqualError(ds.ScanStructs(&items),
+		`goqu: unable to find corresponding field to column "created" returned by query`)
+
+	sds.NoError(ds.ScanMode(goqu.ScanModeIgnoreUnknownColumns).ScanStructs(&items))
+	sds.Equal([]dsTestActionItem{{Address: "111 Test Addr", Name: "Test1"}}, items)
+
+	defer goqu.SetDefaultScanMode(goqu.ScanModeDefault)
+	goqu.SetDefaultScanMode(goqu.ScanModeIgnoreUnknownColumns)
+	items = items[0:0]
+	sds.NoError(ds.ScanStructs(&items))
+	sds.Equal([]dsTestActionItem{{Address: "111 Test Addr", Name: "Test1"}}, items)
+	// the mode of the dataset takes precedence over the default
+	sds.EqualError(ds.ScanMode(goqu.ScanModeMatchColumns).ScanStructs(&items),
+		`goqu: unable to find corresponding field to column "created" returned by query`)
+
+	sds.EqualError(db.From("items").Select("name").ScanMode(goqu.ScanModeStrict).ScanStructs(&items),
+		`goqu: unable to find column "address" in query results to populate corresponding field`)
+	sds.NoError(sqlMock.ExpectationsWereMet())
+}
+
 func (sds *selectDatasetSuite) TestScanStructs_WithPreparedStatements() {
 	mDB, sqlMock, err := sqlmock.New()
 	sds.NoError(err)
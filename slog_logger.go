@@ -0,0 +1,22 @@
+//go:build go1.21
+// +build go1.21
+
+package goqu
+
+import (
+	"context"
+	"log/slog"
+)
+
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// Creates a StructuredLogger that logs to the *slog.Logger, see Database#StructuredLogger.
+func NewSlogLogger(logger *slog.Logger) StructuredLogger {
+	return slogLogger{logger: logger}
+}
+
+func (sl slogLogger) Log(ctx context.Context, level LogLevel, msg string, args ...interface{}) {
+	sl.logger.Log(ctx, slog.Level(level), msg, args...)
+}
@@ -0,0 +1,43 @@
+//go:build go1.21
+// +build go1.21
+
+package goqu_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type slogLoggerSuite struct {
+	suite.Suite
+}
+
+func (sls *slogLoggerSuite) TestLog() {
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	logger := goqu.NewSlogLogger(slog.New(handler))
+
+	logger.Log(context.Background(), goqu.LogLevelDebug, "goqu query", "op", "QUERY")
+	logger.Log(context.Background(), goqu.LogLevelWarn, "goqu slow query", "op", "QUERY", "sql", `SELECT * FROM "items"`)
+	sls.Equal(
+		"level=WARN msg=\"goqu slow query\" op=QUERY sql=\"SELECT * FROM \\\"items\\\"\"\n",
+		buf.String(),
+	)
+}
+
+func TestSlogLoggerSuite(t *testing.T) {
+	suite.Run(t, new(slogLoggerSuite))
+}
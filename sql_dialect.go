@@ -15,11 +15,13 @@ type (
 	// See DefaultAdapter for a concrete implementation and examples.
 	SQLDialect interface {
 		Dialect() string
+		DialectOptions() *SQLDialectOptions
 		ToSelectSQL(b sb.SQLBuilder, clauses exp.SelectClauses)
 		ToUpdateSQL(b sb.SQLBuilder, clauses exp.UpdateClauses)
 		ToInsertSQL(b sb.SQLBuilder, clauses exp.InsertClauses)
 		ToDeleteSQL(b sb.SQLBuilder, clauses exp.DeleteClauses)
 		ToTruncateSQL(b sb.SQLBuilder, clauses exp.TruncateClauses)
+		ToMergeSQL(b sb.SQLBuilder, clauses exp.MergeClauses)
 	}
 	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
 	// either override methods, or more typically update default values.
@@ -32,6 +34,7 @@ type (
 		insertGen      sqlgen.InsertSQLGenerator
 		deleteGen      sqlgen.DeleteSQLGenerator
 		truncateGen    sqlgen.TruncateSQLGenerator
+		mergeGen       sqlgen.MergeSQLGenerator
 	}
 )
 
@@ -75,6 +78,7 @@ func newDialect(dialect string, do *SQLDialectOptions) SQLDialect {
 		insertGen:      sqlgen.NewInsertSQLGenerator(dialect, do),
 		deleteGen:      sqlgen.NewDeleteSQLGenerator(dialect, do),
 		truncateGen:    sqlgen.NewTruncateSQLGenerator(dialect, do),
+		mergeGen:       sqlgen.NewMergeSQLGenerator(dialect, do),
 	}
 }
 
@@ -82,6 +86,10 @@ func (d *sqlDialect) Dialect() string {
 	return d.dialect
 }
 
+func (d *sqlDialect) DialectOptions() *SQLDialectOptions {
+	return d.dialectOptions
+}
+
 func (d *sqlDialect) ToSelectSQL(b sb.SQLBuilder, clauses exp.SelectClauses) {
 	d.selectGen.Generate(b, clauses)
 }
@@ -101,3 +109,7 @@ func (d *sqlDialect) ToDeleteSQL(b sb.SQLBuilder, clauses exp.DeleteClauses) {
 func (d *sqlDialect) ToTruncateSQL(b sb.SQLBuilder, clauses exp.TruncateClauses) {
 	d.truncateGen.Generate(b, clauses)
 }
+
+func (d *sqlDialect) ToMergeSQL(b sb.SQLBuilder, clauses exp.MergeClauses) {
+	d.mergeGen.Generate(b, clauses)
+}
@@ -21,6 +21,13 @@ func (dts *dialectTestSuite) TestDialect() {
 	dts.Equal("test", d.Dialect())
 }
 
+func (dts *dialectTestSuite) TestDialectOptions() {
+	opts := DefaultDialectOptions()
+	d := sqlDialect{dialect: "test", dialectOptions: opts}
+
+	dts.Same(opts, d.DialectOptions())
+}
+
 func (dts *dialectTestSuite) TestToSelectSQL() {
 	opts := DefaultDialectOptions()
 	sm := new(mocks.SelectSQLGenerator)
@@ -73,6 +80,19 @@ func (dts *dialectTestSuite) TestToDeleteSQL() {
 	dm.AssertExpectations(dts.T())
 }
 
+func (dts *dialectTestSuite) TestToMergeSQL() {
+	opts := DefaultDialectOptions()
+	mm := new(mocks.MergeSQLGenerator)
+	d := sqlDialect{dialect: "test", dialectOptions: opts, mergeGen: mm}
+
+	b := sb.NewSQLBuilder(true)
+	mc := exp.NewMergeClauses()
+	mm.On("Generate", b, mc).Return(nil).Once()
+
+	d.ToMergeSQL(b, mc)
+	mm.AssertExpectations(dts.T())
+}
+
 func (dts *dialectTestSuite) TestToTruncateSQL() {
 	opts := DefaultDialectOptions()
 	tm := new(mocks.TruncateSQLGenerator)
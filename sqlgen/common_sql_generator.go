@@ -9,15 +9,15 @@ import (
 var ErrNoUpdatedValuesProvided = errors.New("no update values provided")
 
 func ErrCTENotSupported(dialect string) error {
-	return errors.New("dialect does not support CTE WITH clause [dialect=%s]", dialect)
+	return errors.NewUnsupportedFeatureError("dialect does not support CTE WITH clause [dialect=%s]", dialect)
 }
 
 func ErrRecursiveCTENotSupported(dialect string) error {
-	return errors.New("dialect does not support CTE WITH RECURSIVE clause [dialect=%s]", dialect)
+	return errors.NewUnsupportedFeatureError("dialect does not support CTE WITH RECURSIVE clause [dialect=%s]", dialect)
 }
 
 func ErrReturnNotSupported(dialect string) error {
-	return errors.New("dialect does not support RETURNING clause [dialect=%s]", dialect)
+	return errors.NewUnsupportedFeatureError("dialect does not support RETURNING clause [dialect=%s]", dialect)
 }
 
 func ErrNotSupportedFragment(sqlType string, f SQLFragmentType) error {
@@ -2,8 +2,10 @@ package sqlgen
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -39,6 +41,7 @@ var (
 	)
 	ErrUnexpectedNamedWindow = errors.New(`unexpected named window function`)
 	ErrEmptyCaseWhens        = errors.New(`when conditions not found for case statement`)
+	ErrNoWindowFrameStart    = errors.New(`window frame has no start bound`)
 )
 
 func errUnsupportedExpressionType(e exp.Expression) error {
@@ -62,7 +65,59 @@ func errUnsupportedRangeExpressionOperator(op exp.RangeOperation) error {
 }
 
 func errLateralNotSupported(dialect string) error {
-	return errors.New("dialect does not support lateral expressions [dialect=%s]", dialect)
+	return errors.NewUnsupportedFeatureError("dialect does not support lateral expressions [dialect=%s]", dialect)
+}
+
+func errWindowFrameNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support window frames [dialect=%s]", dialect)
+}
+
+func errUnsupportedWindowFrameUnit(dialect string, unit exp.WindowFrameUnit) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support %s window frames [dialect=%s]", unit, dialect)
+}
+
+func errUnsupportedWindowFrameBound(bound exp.WindowFrameBoundType) error {
+	return errors.New("window frame bound %s not supported", bound)
+}
+
+func errWindowFrameExclusionNotSupported(dialect string, exclusion exp.WindowFrameExclusion) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support window frame %s [dialect=%s]", exclusion, dialect)
+}
+
+func errWithinGroupNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support WITHIN GROUP clause [dialect=%s]", dialect)
+}
+
+func errFilterEmulationNotSupported(dialect, reason string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support FILTER clause %s [dialect=%s]", reason, dialect)
+}
+
+func errGroupingSetNotSupported(dialect string, setType exp.GroupingSetType) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support %s [dialect=%s]", setType, dialect)
+}
+
+func errUnsupportedJSONExpressionOperator(dialect string, op exp.JSONOperation) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support JSON operator '%+v' [dialect=%s]", op, dialect)
+}
+
+func errUnsupportedJSONPathElement(e interface{}) error {
+	return errors.New("unsupported JSON path element %+v, a string or integer is required", e)
+}
+
+func errArraysNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support arrays [dialect=%s]", dialect)
+}
+
+func errUnsupportedArrayExpressionOperator(op exp.ArrayOperation) error {
+	return errors.New("array operator '%+v' not supported", op)
+}
+
+func errUnsupportedArrayValue(val interface{}) error {
+	return errors.New("array value must be a slice received %T", val)
+}
+
+func errExcludedRowNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support references to the row proposed for insertion [dialect=%s]", dialect)
 }
 
 func NewExpressionSQLGenerator(dialect string, do *SQLDialectOptions) ExpressionSQLGenerator {
@@ -83,6 +138,10 @@ func (esg *expressionSQLGenerator) Generate(b sb.SQLBuilder, val interface{}) {
 		esg.literalNil(b)
 		return
 	}
+	if !b.IsSensitive() && exp.IsSensitiveValue(val) {
+		esg.sensitiveValueSQL(b, val)
+		return
+	}
 	switch v := val.(type) {
 	case exp.Expression:
 		esg.expressionSQL(b, v)
@@ -153,10 +212,24 @@ func (esg *expressionSQLGenerator) reflectSQL(b sb.SQLBuilder, val interface{})
 	case util.IsBool(valKind):
 		esg.Generate(b, v.Bool())
 	default:
+		if b.IsSensitive() {
+			b.SetError(errors.NewEncodeError(exp.RedactedValue))
+			return
+		}
 		b.SetError(errors.NewEncodeError(val))
 	}
 }
 
+// Generates the SQL for a value that should be redacted when the statement is logged or traced
+func (esg *expressionSQLGenerator) sensitiveValueSQL(b sb.SQLBuilder, val interface{}) {
+	if s, ok := val.(exp.SensitiveExpression); ok {
+		val = s.Value()
+	}
+	b.StartSensitive()
+	esg.Generate(b, val)
+	b.EndSensitive()
+}
+
 //nolint:gocyclo // not complex just long
 func (esg *expressionSQLGenerator) expressionSQL(b sb.SQLBuilder, expression exp.Expression) {
 	switch e := expression.(type) {
@@ -190,6 +263,14 @@ func (esg *expressionSQLGenerator) expressionSQL(b sb.SQLBuilder, expression exp
 		esg.windowExpressionSQL(b, e)
 	case exp.CastExpression:
 		esg.castExpressionSQL(b, e)
+	case exp.JSONExpression:
+		esg.jsonExpressionSQL(b, e)
+	case exp.ArrayExpression:
+		esg.arrayExpressionSQL(b, e)
+	case exp.ArrayValueExpression:
+		esg.arrayValueExpressionSQL(b, e)
+	case exp.SensitiveExpression:
+		esg.sensitiveValueSQL(b, e)
 	case exp.AppendableExpression:
 		esg.appendableExpressionSQL(b, e)
 	case exp.CommonTableExpression:
@@ -198,6 +279,10 @@ func (esg *expressionSQLGenerator) expressionSQL(b sb.SQLBuilder, expression exp
 		esg.compoundExpressionSQL(b, e)
 	case exp.CaseExpression:
 		esg.caseExpressionSQL(b, e)
+	case exp.GroupingSetExpression:
+		esg.groupingSetExpressionSQL(b, e)
+	case exp.ExcludedExpression:
+		esg.excludedExpressionSQL(b, e)
 	case exp.Ex:
 		esg.expressionMapSQL(b, e)
 	case exp.ExOr:
@@ -386,6 +471,10 @@ func (esg *expressionSQLGenerator) aliasedExpressionSQL(b sb.SQLBuilder, aliased
 
 // Generates SQL for a BooleanExpresion (e.g. I("a").Eq(2) -> "a" = 2)
 func (esg *expressionSQLGenerator) booleanExpressionSQL(b sb.SQLBuilder, operator exp.BooleanExpression) {
+	if esg.useArrayParamForIn(b, operator) {
+		esg.inArrayParamSQL(b, operator)
+		return
+	}
 	b.WriteRunes(esg.dialectOptions.LeftParenRune)
 	esg.Generate(b, operator.LHS())
 	b.WriteRunes(esg.dialectOptions.SpaceRune)
@@ -399,7 +488,7 @@ func (esg *expressionSQLGenerator) booleanExpressionSQL(b sb.SQLBuilder, operato
 	rhs := operator.RHS()
 
 	if (operatorOp == exp.IsOp || operatorOp == exp.IsNotOp) && rhs != nil && !esg.dialectOptions.BooleanDataTypeSupported {
-		b.SetError(errors.New("boolean data type is not supported by dialect %q", esg.dialect))
+		b.SetError(errors.NewUnsupportedFeatureError("boolean data type is not supported by dialect %q", esg.dialect))
 		return
 	}
 
@@ -426,6 +515,94 @@ func (esg *expressionSQLGenerator) booleanExpressionSQL(b sb.SQLBuilder, operato
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
+// returns true if the IN or NOT IN expression should be generated with a single array parameter
+func (esg *expressionSQLGenerator) useArrayParamForIn(b sb.SQLBuilder, operator exp.BooleanExpression) bool {
+	if operator.Op() != exp.InOp && operator.Op() != exp.NotInOp {
+		return false
+	}
+	if !b.IsPrepared() || !esg.dialectOptions.UseArrayParamsForIn || esg.dialectOptions.ArrayValuer == nil {
+		return false
+	}
+	if !exp.IsArrayValue(operator.RHS()) {
+		return false
+	}
+	elements := reflect.Indirect(reflect.ValueOf(operator.RHS()))
+	for i, l := 0, elements.Len(); i < l; i++ {
+		e := elements.Index(i).Interface()
+		if _, ok := e.(exp.Expression); ok || exp.IsArrayValue(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Generates SQL for an IN or NOT IN expression using a single array parameter
+// (e.g. I("a").In([]int{1, 2}) -> ("a" = ANY($1)), I("a").NotIn([]int{1, 2}) -> ("a" != ALL($1)))
+func (esg *expressionSQLGenerator) inArrayParamSQL(b sb.SQLBuilder, operator exp.BooleanExpression) {
+	array := exp.NewArrayValueExpression(operator.RHS())
+	if operator.Op() == exp.InOp {
+		esg.Generate(b, exp.NewBooleanExpression(exp.EqOp, operator.LHS(), exp.NewSQLFunctionExpression("ANY", array)))
+		return
+	}
+	esg.Generate(b, exp.NewBooleanExpression(exp.NeqOp, operator.LHS(), exp.NewSQLFunctionExpression("ALL", array)))
+}
+
+// Generates SQL for an ArrayExpression (e.g. I("tags").Array().Contains([]string{"a"}) -> ("tags" @> ARRAY['a']))
+func (esg *expressionSQLGenerator) arrayExpressionSQL(b sb.SQLBuilder, operator exp.ArrayExpression) {
+	if !esg.dialectOptions.SupportsArrays {
+		b.SetError(errArraysNotSupported(esg.dialect))
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	esg.Generate(b, operator.LHS())
+	b.WriteRunes(esg.dialectOptions.SpaceRune)
+	operatorOp := operator.Op()
+	if val, ok := esg.dialectOptions.ArrayOperatorLookup[operatorOp]; ok {
+		b.Write(val)
+	} else {
+		b.SetError(errUnsupportedArrayExpressionOperator(operatorOp))
+		return
+	}
+	b.WriteRunes(esg.dialectOptions.SpaceRune)
+	rhs := operator.RHS()
+	if exp.IsArrayValue(rhs) {
+		rhs = exp.NewArrayValueExpression(rhs)
+	}
+	esg.Generate(b, rhs)
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for an ArrayValueExpression (e.g. ARRAY[1, 2]). If the statement is prepared and the dialect has an
+// ArrayValuer the slice is added as a single parameter.
+func (esg *expressionSQLGenerator) arrayValueExpressionSQL(b sb.SQLBuilder, av exp.ArrayValueExpression) {
+	if !esg.dialectOptions.SupportsArrays {
+		b.SetError(errArraysNotSupported(esg.dialect))
+		return
+	}
+	if !exp.IsArrayValue(av.Elements()) {
+		b.SetError(errUnsupportedArrayValue(av.Elements()))
+		return
+	}
+	elements := reflect.Indirect(reflect.ValueOf(av.Elements()))
+	if b.IsPrepared() && esg.dialectOptions.ArrayValuer != nil {
+		esg.placeHolderSQL(b, esg.dialectOptions.ArrayValuer(elements.Interface()))
+		return
+	}
+	if elements.Len() == 0 {
+		// an empty ARRAY[] requires a cast so use an empty array literal and let the type be inferred
+		esg.Generate(b, "{}")
+		return
+	}
+	b.Write(esg.dialectOptions.ArrayStartFragment)
+	for i, l := 0, elements.Len(); i < l; i++ {
+		esg.Generate(b, elements.Index(i).Interface())
+		if i < l-1 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+	}
+	b.Write(esg.dialectOptions.ArrayEndFragment)
+}
+
 // Generates SQL for a BitwiseExpresion (e.g. I("a").BitwiseOr(2) - > "a" | 2)
 func (esg *expressionSQLGenerator) bitwiseExpressionSQL(b sb.SQLBuilder, operator exp.BitwiseExpression) {
 	b.WriteRunes(esg.dialectOptions.LeftParenRune)
@@ -448,6 +625,74 @@ func (esg *expressionSQLGenerator) bitwiseExpressionSQL(b sb.SQLBuilder, operato
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
+// Generates SQL for a JSONExpression (e.g. I("doc").JSON().Get("a") -> "doc"->'a'). The operator is written directly
+// so operators such as ? are never treated as placeholders.
+func (esg *expressionSQLGenerator) jsonExpressionSQL(b sb.SQLBuilder, je exp.JSONExpression) {
+	operatorOp := je.Op()
+	val, ok := esg.dialectOptions.JSONOperatorLookup[operatorOp]
+	if !ok {
+		b.SetError(errUnsupportedJSONExpressionOperator(esg.dialect, operatorOp))
+		return
+	}
+	if esg.dialectOptions.UseJSONFunctions {
+		esg.jsonFunctionSQL(b, string(val), je)
+		return
+	}
+	rhs, err := jsonOperatorRHS(je)
+	if err != nil {
+		b.SetError(err)
+		return
+	}
+	switch operatorOp {
+	case exp.JSONContainsOp, exp.JSONHasKeyOp:
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		esg.Generate(b, je.LHS())
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+		b.Write(val)
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+		esg.Generate(b, rhs)
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	default:
+		esg.Generate(b, je.LHS())
+		b.Write(val)
+		esg.Generate(b, rhs)
+	}
+}
+
+// Generates SQL for a JSONExpression using JSON functions
+// (e.g. I("doc").JSON().Get("a") -> JSON_EXTRACT(`doc`, '$.a'))
+func (esg *expressionSQLGenerator) jsonFunctionSQL(b sb.SQLBuilder, fn string, je exp.JSONExpression) {
+	var e exp.Expression
+	switch je.Op() {
+	case exp.JSONContainsOp:
+		doc, err := jsonDocument(je.RHS())
+		if err != nil {
+			b.SetError(err)
+			return
+		}
+		e = exp.NewSQLFunctionExpression(fn, je.LHS(), doc)
+	case exp.JSONHasKeyOp:
+		path, err := jsonPathString([]interface{}{je.RHS()})
+		if err != nil {
+			b.SetError(err)
+			return
+		}
+		e = exp.NewSQLFunctionExpression(fn, je.LHS(), path).IsNotNull()
+	default:
+		path, err := jsonPathString(jsonPathElements(je))
+		if err != nil {
+			b.SetError(err)
+			return
+		}
+		e = exp.NewSQLFunctionExpression(fn, je.LHS(), path)
+		isText := je.Op() == exp.JSONGetTextOp || je.Op() == exp.JSONPathTextOp
+		if unquote := esg.dialectOptions.JSONUnquoteFragment; isText && len(unquote) > 0 {
+			e = exp.NewSQLFunctionExpression(string(unquote), e)
+		}
+	}
+	esg.Generate(b, e)
+}
+
 // Generates SQL for a RangeExpresion (e.g. I("a").Between(RangeVal{Start:2,End:5}) -> "a" BETWEEN 2 AND 5)
 func (esg *expressionSQLGenerator) rangeExpressionSQL(b sb.SQLBuilder, operator exp.RangeExpression) {
 	b.WriteRunes(esg.dialectOptions.LeftParenRune)
@@ -559,8 +804,79 @@ func (esg *expressionSQLGenerator) literalExpressionSQL(b sb.SQLBuilder, literal
 //
 //	COUNT(I("a")) -> COUNT("a")
 func (esg *expressionSQLGenerator) sqlFunctionExpressionSQL(b sb.SQLBuilder, sqlFunc exp.SQLFunctionExpression) {
+	emulateFilter := sqlFunc.HasFilter() && !esg.dialectOptions.SupportsFilterClause
+	if emulateFilter {
+		if reason := unsupportedFilterEmulation(sqlFunc); reason != "" {
+			b.SetError(errFilterEmulationNotSupported(esg.dialect, reason))
+			return
+		}
+	}
 	b.WriteStrings(sqlFunc.Name())
-	esg.Generate(b, sqlFunc.Args())
+	if emulateFilter {
+		esg.Generate(b, filteredFunctionArgs(sqlFunc))
+	} else {
+		esg.Generate(b, sqlFunc.Args())
+	}
+	if sqlFunc.HasWithinGroup() {
+		if !esg.dialectOptions.SupportsWithinGroup {
+			b.SetError(errWithinGroupNotSupported(esg.dialect))
+			return
+		}
+		b.Write(esg.dialectOptions.WithinGroupFragment)
+		esg.Generate(b, sqlFunc.WithinGroupOrder())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+	if sqlFunc.HasFilter() && !emulateFilter {
+		b.Write(esg.dialectOptions.FilterFragment)
+		esg.Generate(b, sqlFunc.FilterClause())
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+}
+
+// Used to emulate a FILTER clause for dialects that do not support it by wrapping the first argument of the
+// function in a CASE WHEN (e.g. COUNT(*) FILTER (WHERE "a" = 1) -> COUNT(CASE WHEN ("a" = 1) THEN 1 END))
+func filteredFunctionArgs(sqlFunc exp.SQLFunctionExpression) []interface{} {
+	args := sqlFunc.Args()
+	arg := args[0]
+	if isStarArg(arg) {
+		arg = exp.NewLiteralExpression("1")
+	}
+	filteredArgs := make([]interface{}, 0, len(args))
+	filteredArgs = append(filteredArgs, exp.NewCaseExpression().When(sqlFunc.FilterClause(), arg))
+	return append(filteredArgs, args[1:]...)
+}
+
+// Returns the reason a FILTER clause can not be emulated with a CASE WHEN or an empty string if it can. Without an
+// argument the filter would be dropped and a DISTINCT argument can not be wrapped in a CASE WHEN.
+func unsupportedFilterEmulation(sqlFunc exp.SQLFunctionExpression) string {
+	args := sqlFunc.Args()
+	if len(args) == 0 {
+		return "on functions without arguments"
+	}
+	if isDistinctArg(args[0]) {
+		return "on DISTINCT arguments"
+	}
+	return ""
+}
+
+func isDistinctArg(arg interface{}) bool {
+	switch t := arg.(type) {
+	case exp.SQLFunctionExpression:
+		return strings.EqualFold(t.Name(), "DISTINCT")
+	case exp.LiteralExpression:
+		return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(t.Literal())), "DISTINCT ")
+	}
+	return false
+}
+
+func isStarArg(arg interface{}) bool {
+	switch t := arg.(type) {
+	case exp.LiteralExpression:
+		return t.Literal() == "*" && len(t.Args()) == 0
+	case exp.IdentifierExpression:
+		return t.GetSchema() == "" && t.GetTable() == "" && isStarArg(t.GetCol())
+	}
+	return false
 }
 
 func (esg *expressionSQLGenerator) sqlWindowFunctionExpression(b sb.SQLBuilder, sqlWinFunc exp.SQLWindowFunctionExpression) {
@@ -597,10 +913,11 @@ func (esg *expressionSQLGenerator) windowExpressionSQL(b sb.SQLBuilder, we exp.W
 
 	hasPartition := we.HasPartitionBy()
 	hasOrder := we.HasOrder()
+	hasFrame := we.HasFrame()
 
 	if we.HasParent() {
 		esg.Generate(b, we.Parent())
-		if hasPartition || hasOrder {
+		if hasPartition || hasOrder || hasFrame {
 			b.WriteRunes(esg.dialectOptions.SpaceRune)
 		}
 	}
@@ -608,18 +925,74 @@ func (esg *expressionSQLGenerator) windowExpressionSQL(b sb.SQLBuilder, we exp.W
 	if hasPartition {
 		b.Write(esg.dialectOptions.WindowPartitionByFragment)
 		esg.Generate(b, we.PartitionCols())
-		if hasOrder {
+		if hasOrder || hasFrame {
 			b.WriteRunes(esg.dialectOptions.SpaceRune)
 		}
 	}
 	if hasOrder {
 		b.Write(esg.dialectOptions.WindowOrderByFragment)
 		esg.Generate(b, we.OrderCols())
+		if hasFrame {
+			b.WriteRunes(esg.dialectOptions.SpaceRune)
+		}
+	}
+	if hasFrame {
+		esg.windowFrameSQL(b, we.Frame())
 	}
 
 	b.WriteRunes(esg.dialectOptions.RightParenRune)
 }
 
+// Generates SQL for a WindowFrame
+//
+//	W().OrderBy("a").Rows(UnboundedPreceding(), CurrentRow()) -> (ORDER BY "a" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)
+func (esg *expressionSQLGenerator) windowFrameSQL(b sb.SQLBuilder, frame exp.WindowFrame) {
+	if !esg.dialectOptions.SupportsWindowFrame {
+		b.SetError(errWindowFrameNotSupported(esg.dialect))
+		return
+	}
+	if frame.Start() == nil {
+		b.SetError(ErrNoWindowFrameStart)
+		return
+	}
+	unit, ok := esg.dialectOptions.WindowFrameUnitLookup[frame.Unit()]
+	if !ok {
+		b.SetError(errUnsupportedWindowFrameUnit(esg.dialect, frame.Unit()))
+		return
+	}
+	b.Write(unit)
+	if frame.HasEnd() {
+		b.Write(esg.dialectOptions.WindowBetweenFragment)
+		esg.windowFrameBoundSQL(b, frame.Start())
+		b.Write(esg.dialectOptions.AndFragment)
+		esg.windowFrameBoundSQL(b, frame.End())
+	} else {
+		b.WriteRunes(esg.dialectOptions.SpaceRune)
+		esg.windowFrameBoundSQL(b, frame.Start())
+	}
+	if frame.HasExclusion() {
+		exclusion, ok := esg.dialectOptions.WindowFrameExclusionLookup[frame.Exclusion()]
+		if !esg.dialectOptions.SupportsWindowFrameExclusion || !ok {
+			b.SetError(errWindowFrameExclusionNotSupported(esg.dialect, frame.Exclusion()))
+			return
+		}
+		b.Write(exclusion)
+	}
+}
+
+func (esg *expressionSQLGenerator) windowFrameBoundSQL(b sb.SQLBuilder, bound exp.WindowFrameBound) {
+	fragment, ok := esg.dialectOptions.WindowFrameBoundLookup[bound.Type()]
+	if !ok {
+		b.SetError(errUnsupportedWindowFrameBound(bound.Type()))
+		return
+	}
+	switch bound.Type() {
+	case exp.PrecedingFrameBound, exp.FollowingFrameBound:
+		esg.Generate(b, bound.Offset())
+	}
+	b.Write(fragment)
+}
+
 // Generates SQL for a CastExpression
 //
 //	I("a").Cast("NUMERIC") -> CAST("a" AS NUMERIC)
@@ -680,6 +1053,10 @@ func (esg *expressionSQLGenerator) compoundExpressionSQL(b sb.SQLBuilder, compou
 		b.Write(esg.dialectOptions.IntersectFragment)
 	case exp.IntersectAllCompoundType:
 		b.Write(esg.dialectOptions.IntersectAllFragment)
+	case exp.ExceptCompoundType:
+		b.Write(esg.dialectOptions.ExceptFragment)
+	case exp.ExceptAllCompoundType:
+		b.Write(esg.dialectOptions.ExceptAllFragment)
 	}
 	if esg.dialectOptions.WrapCompoundsInParens {
 		b.WriteRunes(esg.dialectOptions.LeftParenRune)
@@ -717,6 +1094,141 @@ func (esg *expressionSQLGenerator) caseExpressionSQL(b sb.SQLBuilder, caseExpres
 	b.Write(esg.dialectOptions.EndFragment)
 }
 
+// Generates SQL for a GroupingSetExpression (e.g. ROLLUP("a", ("b", "c")))
+func (esg *expressionSQLGenerator) groupingSetExpressionSQL(b sb.SQLBuilder, gs exp.GroupingSetExpression) {
+	setType, ok := esg.dialectOptions.GroupingSetTypeLookup[gs.Type()]
+	if !ok {
+		b.SetError(errGroupingSetNotSupported(esg.dialect, gs.Type()))
+		return
+	}
+	b.Write(setType)
+	b.WriteRunes(esg.dialectOptions.LeftParenRune)
+	for i, element := range gs.Elements() {
+		if i > 0 {
+			b.WriteRunes(esg.dialectOptions.CommaRune, esg.dialectOptions.SpaceRune)
+		}
+		if len(element.Columns()) == 1 {
+			esg.Generate(b, element.Columns()[0])
+			continue
+		}
+		b.WriteRunes(esg.dialectOptions.LeftParenRune)
+		if !element.IsEmpty() {
+			esg.Generate(b, element)
+		}
+		b.WriteRunes(esg.dialectOptions.RightParenRune)
+	}
+	b.WriteRunes(esg.dialectOptions.RightParenRune)
+}
+
+// Generates SQL for a reference to a column of the row proposed for insertion (e.g. EXCLUDED."a" or VALUES(`a`))
+func (esg *expressionSQLGenerator) excludedExpressionSQL(b sb.SQLBuilder, e exp.ExcludedExpression) {
+	if !esg.dialectOptions.SupportsExcludedRow {
+		b.SetError(errExcludedRowNotSupported(esg.dialect))
+		return
+	}
+	b.Write(esg.dialectOptions.ExcludedPrefixFragment)
+	esg.Generate(b, e.Column())
+	b.Write(esg.dialectOptions.ExcludedSuffixFragment)
+}
+
+// returns the right hand side of a JSONExpression when using JSON operators
+func jsonOperatorRHS(je exp.JSONExpression) (interface{}, error) {
+	switch je.Op() {
+	case exp.JSONPathOp, exp.JSONPathTextOp:
+		return jsonPathArray(jsonPathElements(je))
+	case exp.JSONContainsOp:
+		return jsonDocument(je.RHS())
+	default:
+		return je.RHS(), nil
+	}
+}
+
+// returns the path elements of a JSONExpression that extracts a value
+func jsonPathElements(je exp.JSONExpression) []interface{} {
+	if path, ok := je.RHS().([]interface{}); ok && (je.Op() == exp.JSONPathOp || je.Op() == exp.JSONPathTextOp) {
+		return path
+	}
+	return []interface{}{je.RHS()}
+}
+
+// encodes a value as a JSON document unless it is already a string, []byte or Expression
+func jsonDocument(val interface{}) (interface{}, error) {
+	switch val.(type) {
+	case string, []byte, exp.Expression:
+		return val, nil
+	}
+	doc, err := json.Marshal(val)
+	if err != nil {
+		return nil, err
+	}
+	return string(doc), nil
+}
+
+// converts a JSON path to a text array (e.g. []interface{}{"a", 0} -> {a,0})
+func jsonPathArray(path []interface{}) (string, error) {
+	elements := make([]string, 0, len(path))
+	for _, p := range path {
+		e, isIndex, err := jsonPathElement(p)
+		if err != nil {
+			return "", err
+		}
+		if !isIndex && (e == "" || strings.ContainsAny(e, "{}\",\\ \t\n") || strings.EqualFold(e, "NULL")) {
+			e = strconv.Quote(e)
+		}
+		elements = append(elements, e)
+	}
+	return "{" + strings.Join(elements, ",") + "}", nil
+}
+
+// converts a JSON path to a JSON path expression (e.g. []interface{}{"a", 0} -> $.a[0])
+func jsonPathString(path []interface{}) (string, error) {
+	var buf strings.Builder
+	buf.WriteString("$")
+	for _, p := range path {
+		e, isIndex, err := jsonPathElement(p)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case isIndex:
+			buf.WriteString("[" + e + "]")
+		case isJSONPathIdentifier(e):
+			buf.WriteString("." + e)
+		default:
+			buf.WriteString("." + strconv.Quote(e))
+		}
+	}
+	return buf.String(), nil
+}
+
+// returns the string value of a path element and true if the element is an array index
+func jsonPathElement(p interface{}) (element string, isIndex bool, err error) {
+	v := reflect.Indirect(reflect.ValueOf(p))
+	switch k := v.Kind(); {
+	case util.IsString(k):
+		return v.String(), false, nil
+	case util.IsInt(k):
+		return strconv.FormatInt(v.Int(), 10), true, nil
+	case util.IsUint(k):
+		return strconv.FormatUint(v.Uint(), 10), true, nil
+	}
+	return "", false, errUnsupportedJSONPathElement(p)
+}
+
+// returns true if the key can be used in a JSON path without quoting
+func isJSONPathIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, r := range key {
+		isLetter := r == '_' || r == '$' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !isLetter && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
 func (esg *expressionSQLGenerator) expressionMapSQL(b sb.SQLBuilder, ex exp.Ex) {
 	expressionList, err := ex.ToExpressions()
 	if err != nil {
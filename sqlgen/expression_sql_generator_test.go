@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -661,6 +662,271 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_BitwiseExpression() {
 		expressionTestCase{val: ident.BitwiseRightShift(1), err: "goqu: bitwise operator 'Right Shift' not supported"},
 	)
 }
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_JSONExpression() {
+	doc := exp.NewIdentifierExpression("", "", "doc")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: doc.JSON().Get("a"), sql: `"doc"->'a'`},
+		expressionTestCase{val: doc.JSON().Get("a"), sql: `"doc"->?`, isPrepared: true, args: []interface{}{"a"}},
+
+		expressionTestCase{val: doc.JSON().Get("a").Get(0), sql: `"doc"->'a'->0`},
+		expressionTestCase{
+			val:        doc.JSON().Get("a").Get(0),
+			sql:        `"doc"->?->?`,
+			isPrepared: true,
+			args:       []interface{}{"a", int64(0)},
+		},
+
+		expressionTestCase{val: doc.JSON().GetText("a"), sql: `"doc"->>'a'`},
+		expressionTestCase{val: doc.JSON().GetText("a"), sql: `"doc"->>?`, isPrepared: true, args: []interface{}{"a"}},
+
+		expressionTestCase{val: doc.JSON().Path("a", "b c", 1), sql: `"doc"#>'{a,"b c",1}'`},
+		expressionTestCase{
+			val:        doc.JSON().Path("a", "b c", 1),
+			sql:        `"doc"#>?`,
+			isPrepared: true,
+			args:       []interface{}{`{a,"b c",1}`},
+		},
+
+		expressionTestCase{val: doc.JSON().PathText("a", "null", ""), sql: `"doc"#>>'{a,"null",""}'`},
+		expressionTestCase{
+			val:        doc.JSON().PathText("a", "null", ""),
+			sql:        `"doc"#>>?`,
+			isPrepared: true,
+			args:       []interface{}{`{a,"null",""}`},
+		},
+
+		expressionTestCase{val: doc.JSON().Contains(map[string]int{"a": 1}), sql: `("doc" @> '{"a":1}')`},
+		expressionTestCase{
+			val:        doc.JSON().Contains(map[string]int{"a": 1}),
+			sql:        `("doc" @> ?)`,
+			isPrepared: true,
+			args:       []interface{}{`{"a":1}`},
+		},
+		expressionTestCase{val: doc.JSON().Contains(`{"a":1}`), sql: `("doc" @> '{"a":1}')`},
+
+		// the ? operator is written directly and never treated as a placeholder
+		expressionTestCase{val: doc.JSON().HasKey("a"), sql: `("doc" ? 'a')`},
+		expressionTestCase{val: doc.JSON().HasKey("a"), sql: `("doc" ? ?)`, isPrepared: true, args: []interface{}{"a"}},
+
+		expressionTestCase{val: doc.JSON().GetText("a").Eq("b"), sql: `("doc"->>'a' = 'b')`},
+		expressionTestCase{val: doc.JSON().Path(true), err: "goqu: unsupported JSON path element true, a string or integer is required"},
+		expressionTestCase{val: doc.JSON().Contains(make(chan int)), err: "json: unsupported type: chan int"},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.UseJSONFunctions = true
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{
+		exp.JSONGetOp:      []byte("JSON_EXTRACT"),
+		exp.JSONGetTextOp:  []byte("JSON_EXTRACT"),
+		exp.JSONPathOp:     []byte("JSON_EXTRACT"),
+		exp.JSONPathTextOp: []byte("JSON_EXTRACT"),
+		exp.JSONContainsOp: []byte("JSON_CONTAINS"),
+		exp.JSONHasKeyOp:   []byte("JSON_TYPE"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: doc.JSON().Get("a"), sql: `JSON_EXTRACT("doc", '$.a')`},
+		expressionTestCase{val: doc.JSON().Get("a"), sql: `JSON_EXTRACT("doc", ?)`, isPrepared: true, args: []interface{}{"$.a"}},
+		expressionTestCase{val: doc.JSON().Get(1), sql: `JSON_EXTRACT("doc", '$[1]')`},
+		expressionTestCase{val: doc.JSON().Get("a").Get("b"), sql: `JSON_EXTRACT(JSON_EXTRACT("doc", '$.a'), '$.b')`},
+		expressionTestCase{val: doc.JSON().GetText("a"), sql: `JSON_EXTRACT("doc", '$.a')`},
+		expressionTestCase{val: doc.JSON().Path("a", "b c", 1), sql: `JSON_EXTRACT("doc", '$.a."b c"[1]')`},
+		expressionTestCase{val: doc.JSON().PathText("a", "1b", "_c$"), sql: `JSON_EXTRACT("doc", '$.a."1b"._c$')`},
+		expressionTestCase{val: doc.JSON().Contains(map[string]int{"a": 1}), sql: `JSON_CONTAINS("doc", '{"a":1}')`},
+		expressionTestCase{val: doc.JSON().HasKey("a"), sql: `(JSON_TYPE("doc", '$.a') IS NOT NULL)`},
+		expressionTestCase{
+			val:        doc.JSON().HasKey("a"),
+			sql:        `(JSON_TYPE("doc", ?) IS NOT NULL)`,
+			isPrepared: true,
+			args:       []interface{}{"$.a"},
+		},
+		expressionTestCase{val: doc.JSON().Get(1.1), err: "goqu: unsupported JSON path element 1.1, a string or integer is required"},
+	)
+
+	opts.JSONUnquoteFragment = []byte("JSON_UNQUOTE")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: doc.JSON().Get("a"), sql: `JSON_EXTRACT("doc", '$.a')`},
+		expressionTestCase{val: doc.JSON().GetText("a"), sql: `JSON_UNQUOTE(JSON_EXTRACT("doc", '$.a'))`},
+		expressionTestCase{val: doc.JSON().PathText("a", 0), sql: `JSON_UNQUOTE(JSON_EXTRACT("doc", '$.a[0]'))`},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.JSONOperatorLookup = map[exp.JSONOperation][]byte{}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: doc.JSON().Get("a"), err: "goqu: dialect does not support JSON operator 'get' [dialect=test]"},
+		expressionTestCase{val: doc.JSON().HasKey("a"), err: "goqu: dialect does not support JSON operator 'has key' [dialect=test]"},
+	)
+}
+
+type testArrayValuer struct {
+	elements interface{}
+}
+
+func (tav testArrayValuer) Value() (driver.Value, error) {
+	return fmt.Sprintf("%v", tav.elements), nil
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ArrayExpression() {
+	tags := exp.NewIdentifierExpression("", "", "tags")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: tags.Array().Contains([]string{"a", "b"}), sql: `("tags" @> ARRAY['a', 'b'])`},
+		expressionTestCase{
+			val:        tags.Array().Contains([]string{"a", "b"}),
+			sql:        `("tags" @> ARRAY[?, ?])`,
+			isPrepared: true,
+			args:       []interface{}{"a", "b"},
+		},
+		expressionTestCase{val: tags.Array().ContainedBy([]int{1}), sql: `("tags" <@ ARRAY[1])`},
+		expressionTestCase{val: tags.Array().Overlaps(exp.NewIdentifierExpression("", "", "other")), sql: `("tags" && "other")`},
+		expressionTestCase{val: tags.Array().Concat([]string{}), sql: `("tags" || '{}')`},
+		expressionTestCase{val: tags.Array().Concat("a"), sql: `("tags" || 'a')`},
+		expressionTestCase{val: tags.Array().Contains([]string{"a"}).IsTrue(), sql: `(("tags" @> ARRAY['a']) IS TRUE)`},
+		expressionTestCase{
+			val: exp.NewArrayExpression(exp.ArrayOperation(100), tags, []string{"a"}),
+			err: "goqu: array operator '100' not supported",
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ArrayValuer = func(elements interface{}) driver.Valuer { return testArrayValuer{elements} }
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: tags.Array().Contains([]string{"a", "b"}), sql: `("tags" @> ARRAY['a', 'b'])`},
+		expressionTestCase{
+			val:        tags.Array().Contains([]string{"a", "b"}),
+			sql:        `("tags" @> ?)`,
+			isPrepared: true,
+			args:       []interface{}{testArrayValuer{[]string{"a", "b"}}},
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SupportsArrays = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: tags.Array().Contains([]string{"a"}), err: "goqu: dialect does not support arrays [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ArrayValueExpression() {
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: exp.NewArrayValueExpression([]int64{1, 2}), sql: `ARRAY[1, 2]`},
+		expressionTestCase{
+			val:        exp.NewArrayValueExpression([]int64{1, 2}),
+			sql:        `ARRAY[?, ?]`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(2)},
+		},
+		expressionTestCase{val: exp.NewArrayValueExpression([]string{}), sql: `'{}'`},
+		expressionTestCase{val: exp.NewArrayValueExpression("a"), err: "goqu: array value must be a slice received string"},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsArrays = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: exp.NewArrayValueExpression([]int64{1}), err: "goqu: dialect does not support arrays [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SensitiveExpression() {
+	exp.RegisterSensitiveValueMatcher(func(value interface{}) bool {
+		s, ok := value.(string)
+		return ok && strings.HasPrefix(s, "sqlgen_sensitive_test_")
+	})
+	type strct struct{ Secret string }
+	ident := exp.NewIdentifierExpression("", "", "a")
+	esg := sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions())
+	esgs.assertCases(
+		esg,
+		expressionTestCase{val: exp.NewSensitiveExpression("secret"), sql: `'secret'`},
+		expressionTestCase{val: ident.Eq(exp.NewSensitiveExpression(1)), sql: `("a" = 1)`},
+		expressionTestCase{
+			val:        ident.Eq(exp.NewSensitiveExpression("secret")),
+			sql:        `("a" = ?)`,
+			isPrepared: true,
+			args:       []interface{}{"secret"},
+		},
+		expressionTestCase{
+			val: exp.NewSensitiveExpression(strct{Secret: "secret"}),
+			err: "goqu_encode_error: Unable to encode value [REDACTED]",
+		},
+	)
+
+	cases := []struct {
+		val          interface{}
+		isPrepared   bool
+		redactedSQL  string
+		redactedArgs []interface{}
+	}{
+		{val: ident.Eq(exp.NewSensitiveExpression("it's")), redactedSQL: `("a" = [REDACTED])`},
+		{
+			val:          ident.Eq(exp.NewSensitiveExpression("secret")),
+			isPrepared:   true,
+			redactedSQL:  `("a" = ?)`,
+			redactedArgs: []interface{}{exp.RedactedValue},
+		},
+		{
+			val:         ident.In([]interface{}{1, exp.NewSensitiveExpression("b"), "sqlgen_sensitive_test_c", "d"}),
+			redactedSQL: `("a" IN (1, [REDACTED], [REDACTED], 'd'))`,
+		},
+		{
+			val:          ident.In([]interface{}{1, exp.NewSensitiveExpression("b"), "sqlgen_sensitive_test_c", "d"}),
+			isPrepared:   true,
+			redactedSQL:  `("a" IN (?, ?, ?, ?))`,
+			redactedArgs: []interface{}{int64(1), exp.RedactedValue, exp.RedactedValue, "d"},
+		},
+		{val: ident.Eq("secret"), redactedSQL: `("a" = 'secret')`},
+	}
+	for i, c := range cases {
+		b := sb.NewSQLBuilder(c.isPrepared)
+		esg.Generate(b, c.val)
+		redactedSQL, redactedArgs, err := b.ToRedactedSQL()
+		esgs.NoError(err, "test case %d failed", i)
+		esgs.Equal(c.redactedSQL, redactedSQL, "test case %d failed", i)
+		if c.redactedArgs != nil {
+			esgs.Equal(c.redactedArgs, redactedArgs, "test case %d failed", i)
+		} else {
+			esgs.Empty(redactedArgs, "test case %d failed", i)
+		}
+	}
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_InArrayParams() {
+	ident := exp.NewIdentifierExpression("", "", "a")
+	opts := sqlgen.DefaultDialectOptions()
+	opts.UseArrayParamsForIn = true
+	opts.ArrayValuer = func(elements interface{}) driver.Valuer { return testArrayValuer{elements} }
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ident.In([]int64{1, 2}), sql: `("a" IN (1, 2))`},
+		expressionTestCase{
+			val:        ident.In([]int64{1, 2}),
+			sql:        `("a" = ANY(?))`,
+			isPrepared: true,
+			args:       []interface{}{testArrayValuer{[]int64{1, 2}}},
+		},
+		expressionTestCase{
+			val:        ident.NotIn([]int64{1, 2}),
+			sql:        `("a" != ALL(?))`,
+			isPrepared: true,
+			args:       []interface{}{testArrayValuer{[]int64{1, 2}}},
+		},
+		// values containing expressions are still expanded
+		expressionTestCase{
+			val:        ident.In([]interface{}{1, exp.NewIdentifierExpression("", "", "b")}),
+			sql:        `("a" IN (?, "b"))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_RangeExpression() {
 	betweenNum := exp.NewIdentifierExpression("", "", "a").
 		Between(exp.NewRangeVal(1, 2))
@@ -769,6 +1035,189 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLFunctionExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLFunctionExpressionWithFilter() {
+	a := exp.NewIdentifierExpression("", "", "a")
+	b := exp.NewIdentifierExpression("", "", "b")
+	count := exp.NewSQLFunctionExpression("COUNT", exp.Star()).Filter(a.Eq(1))
+	sum := exp.NewSQLFunctionExpression("SUM", b).Filter(a.Eq(1), b.Gt(2))
+	countIdent := exp.NewSQLFunctionExpression("COUNT", exp.ParseIdentifier("*")).Filter(a.Eq(1))
+	stringAgg := exp.NewSQLFunctionExpression("STRING_AGG", b, ",").Filter(a.Eq(1))
+	noArgs := exp.NewSQLFunctionExpression("ROW_NUMBER").Filter(a.Eq(1))
+	countDistinct := exp.NewSQLFunctionExpression("COUNT", exp.NewSQLFunctionExpression("DISTINCT", b)).Filter(a.Eq(1))
+	countDistinctLit := exp.NewSQLFunctionExpression("COUNT", exp.NewLiteralExpression(`DISTINCT "b"`)).Filter(a.Eq(1))
+	countOver := count.Over(exp.NewWindowExpression(nil, nil, exp.NewColumnListExpression(b), nil))
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: count, sql: `COUNT(*) FILTER (WHERE ("a" = 1))`},
+		expressionTestCase{val: count, sql: `COUNT(*) FILTER (WHERE ("a" = ?))`, isPrepared: true, args: []interface{}{int64(1)}},
+
+		expressionTestCase{val: sum, sql: `SUM("b") FILTER (WHERE (("a" = 1) AND ("b" > 2)))`},
+		expressionTestCase{
+			val:        sum,
+			sql:        `SUM("b") FILTER (WHERE (("a" = ?) AND ("b" > ?)))`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), int64(2)},
+		},
+
+		expressionTestCase{val: countOver, sql: `COUNT(*) FILTER (WHERE ("a" = 1)) OVER (PARTITION BY "b")`},
+		expressionTestCase{val: countDistinct, sql: `COUNT(DISTINCT("b")) FILTER (WHERE ("a" = 1))`},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsFilterClause = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: count, sql: `COUNT(CASE  WHEN ("a" = 1) THEN 1 END)`},
+		expressionTestCase{
+			val:        count,
+			sql:        `COUNT(CASE  WHEN ("a" = ?) THEN 1 END)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1)},
+		},
+
+		expressionTestCase{val: countIdent, sql: `COUNT(CASE  WHEN ("a" = 1) THEN 1 END)`},
+		expressionTestCase{val: sum, sql: `SUM(CASE  WHEN (("a" = 1) AND ("b" > 2)) THEN "b" END)`},
+		expressionTestCase{val: stringAgg, sql: `STRING_AGG(CASE  WHEN ("a" = 1) THEN "b" END, ',')`},
+		expressionTestCase{
+			val:        stringAgg,
+			sql:        `STRING_AGG(CASE  WHEN ("a" = ?) THEN "b" END, ?)`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), ","},
+		},
+
+		expressionTestCase{
+			val: noArgs,
+			err: "goqu: dialect does not support FILTER clause on functions without arguments [dialect=test]",
+		},
+		expressionTestCase{
+			val:        noArgs,
+			err:        "goqu: dialect does not support FILTER clause on functions without arguments [dialect=test]",
+			isPrepared: true,
+		},
+		expressionTestCase{
+			val: countDistinct,
+			err: "goqu: dialect does not support FILTER clause on DISTINCT arguments [dialect=test]",
+		},
+		expressionTestCase{
+			val:        countDistinct,
+			err:        "goqu: dialect does not support FILTER clause on DISTINCT arguments [dialect=test]",
+			isPrepared: true,
+		},
+		expressionTestCase{
+			val: countDistinctLit,
+			err: "goqu: dialect does not support FILTER clause on DISTINCT arguments [dialect=test]",
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLFunctionExpressionWithinGroup() {
+	a := exp.NewIdentifierExpression("", "", "a")
+	b := exp.NewIdentifierExpression("", "", "b")
+	percentile := exp.NewSQLFunctionExpression("percentile_cont", 0.5).WithinGroup(a.Asc())
+	mode := exp.NewSQLFunctionExpression("mode").WithinGroup(a.Asc(), b.Desc())
+	filtered := percentile.Filter(b.Eq(1))
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: percentile, sql: `percentile_cont(0.5) WITHIN GROUP (ORDER BY "a" ASC)`},
+		expressionTestCase{
+			val:        percentile,
+			sql:        `percentile_cont(?) WITHIN GROUP (ORDER BY "a" ASC)`,
+			isPrepared: true,
+			args:       []interface{}{0.5},
+		},
+
+		expressionTestCase{val: mode, sql: `mode() WITHIN GROUP (ORDER BY "a" ASC, "b" DESC)`},
+		expressionTestCase{
+			val: filtered,
+			sql: `percentile_cont(0.5) WITHIN GROUP (ORDER BY "a" ASC) FILTER (WHERE ("b" = 1))`,
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsWithinGroup = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: percentile, err: "goqu: dialect does not support WITHIN GROUP clause [dialect=test]"},
+		expressionTestCase{
+			val:        percentile,
+			err:        "goqu: dialect does not support WITHIN GROUP clause [dialect=test]",
+			isPrepared: true,
+		},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_GroupingSetExpression() {
+	rollup := exp.NewGroupingSetExpression(exp.RollupGroupingSetType, "a", "b")
+	rollupComposite := exp.NewGroupingSetExpression(exp.RollupGroupingSetType, "a", []string{"b", "c"})
+	cube := exp.NewGroupingSetExpression(exp.CubeGroupingSetType, "a", []interface{}{"b", exp.NewIdentifierExpression("", "", "c")})
+	groupingSets := exp.NewGroupingSetExpression(
+		exp.GroupingSetsGroupingSetType,
+		[]string{"a", "b"},
+		"a",
+		exp.NewColumnListExpression("b"),
+		[]string{},
+	)
+	literalSet := exp.NewGroupingSetExpression(exp.RollupGroupingSetType, exp.NewLiteralExpression("DATE(?)", "2020-01-01"))
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: rollup, sql: `ROLLUP("a", "b")`},
+		expressionTestCase{val: rollup, sql: `ROLLUP("a", "b")`, isPrepared: true},
+
+		expressionTestCase{val: rollupComposite, sql: `ROLLUP("a", ("b", "c"))`},
+		expressionTestCase{val: cube, sql: `CUBE("a", ("b", "c"))`},
+		expressionTestCase{val: groupingSets, sql: `GROUPING SETS(("a", "b"), "a", "b", ())`},
+
+		expressionTestCase{val: literalSet, sql: `ROLLUP(DATE('2020-01-01'))`},
+		expressionTestCase{
+			val:        literalSet,
+			sql:        `ROLLUP(DATE(?))`,
+			isPrepared: true,
+			args:       []interface{}{"2020-01-01"},
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.GroupingSetTypeLookup = map[exp.GroupingSetType][]byte{
+		exp.RollupGroupingSetType: []byte("rollup"),
+	}
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: rollup, sql: `rollup("a", "b")`},
+		expressionTestCase{val: cube, err: "goqu: dialect does not support CUBE [dialect=test]"},
+		expressionTestCase{val: groupingSets, err: "goqu: dialect does not support GROUPING SETS [dialect=test]"},
+	)
+}
+
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_ExcludedExpression() {
+	ex := exp.NewExcludedExpression("a")
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: ex, sql: `EXCLUDED."a"`},
+		expressionTestCase{val: ex, sql: `EXCLUDED."a"`, isPrepared: true},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.ExcludedPrefixFragment = []byte("VALUES(")
+	opts.ExcludedSuffixFragment = []byte(")")
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ex, sql: `VALUES("a")`},
+		expressionTestCase{val: ex, sql: `VALUES("a")`, isPrepared: true},
+	)
+
+	opts.SupportsExcludedRow = false
+	expectedErr := "goqu: dialect does not support references to the row proposed for insertion [dialect=test]"
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: ex, err: expectedErr},
+		expressionTestCase{val: ex, err: expectedErr, isPrepared: true},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_SQLWindowFunctionExpression() {
 	sqlWinFunc := exp.NewSQLWindowFunctionExpression(
 		exp.NewSQLFunctionExpression("some_func"),
@@ -893,6 +1342,105 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_WindowExpression() {
 	)
 }
 
+func (esgs *expressionSQLGeneratorSuite) TestGenerate_WindowExpressionWithFrame() {
+	unboundedPreceding := exp.NewWindowFrameBound(exp.UnboundedPrecedingFrameBound, nil)
+	preceding := exp.NewWindowFrameBound(exp.PrecedingFrameBound, 2)
+	currentRow := exp.NewWindowFrameBound(exp.CurrentRowFrameBound, nil)
+	following := exp.NewWindowFrameBound(exp.FollowingFrameBound, 3)
+	unboundedFollowing := exp.NewWindowFrameBound(exp.UnboundedFollowingFrameBound, nil)
+
+	we := exp.NewWindowExpression(nil, nil, nil, nil)
+	orderedWe := we.OrderBy(exp.NewIdentifierExpression("", "", "a").Asc())
+
+	rowsStart := we.Rows(unboundedPreceding, nil)
+	rowsBetween := orderedWe.Rows(unboundedPreceding, currentRow)
+	rangeBetween := orderedWe.Range(preceding, following)
+	groupsBetween := orderedWe.Groups(currentRow, unboundedFollowing)
+	partitioned := we.PartitionBy("b").Rows(preceding, nil)
+	inherited := exp.NewWindowExpression(nil, exp.NewIdentifierExpression("", "", "w"), nil, nil).
+		Rows(currentRow, nil)
+	excludeCurrentRow := rowsBetween.Exclude(exp.ExcludeCurrentRowFrameExclusion)
+	excludeGroup := rowsBetween.Exclude(exp.ExcludeGroupFrameExclusion)
+	excludeTies := rowsBetween.Exclude(exp.ExcludeTiesFrameExclusion)
+	excludeNoOthers := rowsBetween.Exclude(exp.ExcludeNoOthersFrameExclusion)
+	noStart := orderedWe.Exclude(exp.ExcludeTiesFrameExclusion)
+
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		expressionTestCase{val: rowsStart, sql: `(ROWS UNBOUNDED PRECEDING)`},
+		expressionTestCase{val: rowsStart, sql: `(ROWS UNBOUNDED PRECEDING)`, isPrepared: true},
+
+		expressionTestCase{val: rowsBetween, sql: `(ORDER BY "a" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`},
+		expressionTestCase{
+			val:        rowsBetween,
+			sql:        `(ORDER BY "a" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`,
+			isPrepared: true,
+		},
+
+		expressionTestCase{val: rangeBetween, sql: `(ORDER BY "a" ASC RANGE BETWEEN 2 PRECEDING AND 3 FOLLOWING)`},
+		expressionTestCase{
+			val:        rangeBetween,
+			sql:        `(ORDER BY "a" ASC RANGE BETWEEN ? PRECEDING AND ? FOLLOWING)`,
+			isPrepared: true,
+			args:       []interface{}{int64(2), int64(3)},
+		},
+
+		expressionTestCase{val: groupsBetween, sql: `(ORDER BY "a" ASC GROUPS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)`},
+		expressionTestCase{
+			val:        groupsBetween,
+			sql:        `(ORDER BY "a" ASC GROUPS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)`,
+			isPrepared: true,
+		},
+
+		expressionTestCase{val: partitioned, sql: `(PARTITION BY "b" ROWS 2 PRECEDING)`},
+		expressionTestCase{val: inherited, sql: `("w" ROWS CURRENT ROW)`},
+
+		expressionTestCase{
+			val: excludeCurrentRow,
+			sql: `(ORDER BY "a" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE CURRENT ROW)`,
+		},
+		expressionTestCase{
+			val: excludeGroup,
+			sql: `(ORDER BY "a" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE GROUP)`,
+		},
+		expressionTestCase{
+			val: excludeTies,
+			sql: `(ORDER BY "a" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE TIES)`,
+		},
+		expressionTestCase{
+			val: excludeNoOthers,
+			sql: `(ORDER BY "a" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE NO OTHERS)`,
+		},
+
+		expressionTestCase{val: noStart, err: sqlgen.ErrNoWindowFrameStart.Error()},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsWindowFrame = false
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: rowsBetween, err: "goqu: dialect does not support window frames [dialect=test]"},
+		expressionTestCase{
+			val:        rowsBetween,
+			err:        "goqu: dialect does not support window frames [dialect=test]",
+			isPrepared: true,
+		},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SupportsWindowFrameExclusion = false
+	delete(opts.WindowFrameUnitLookup, exp.GroupsFrameUnit)
+	esgs.assertCases(
+		sqlgen.NewExpressionSQLGenerator("test", opts),
+		expressionTestCase{val: rowsBetween, sql: `(ORDER BY "a" ASC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`},
+		expressionTestCase{val: groupsBetween, err: "goqu: dialect does not support GROUPS window frames [dialect=test]"},
+		expressionTestCase{
+			val: excludeTies,
+			err: "goqu: dialect does not support window frame EXCLUDE TIES [dialect=test]",
+		},
+	)
+}
+
 func (esgs *expressionSQLGeneratorSuite) TestGenerate_CastExpression() {
 	cast := exp.NewIdentifierExpression("", "", "a").Cast("DATE")
 	esgs.assertCases(
@@ -1036,6 +1584,9 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_CompoundExpression() {
 	i := exp.NewCompoundExpression(exp.IntersectCompoundType, ae)
 	ia := exp.NewCompoundExpression(exp.IntersectAllCompoundType, ae)
 
+	e := exp.NewCompoundExpression(exp.ExceptCompoundType, ae)
+	ea := exp.NewCompoundExpression(exp.ExceptAllCompoundType, ae)
+
 	esgs.assertCases(
 		sqlgen.NewExpressionSQLGenerator("test", sqlgen.DefaultDialectOptions()),
 		expressionTestCase{val: u, sql: ` UNION (SELECT * FROM "b")`},
@@ -1049,6 +1600,12 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_CompoundExpression() {
 
 		expressionTestCase{val: ia, sql: ` INTERSECT ALL (SELECT * FROM "b")`},
 		expressionTestCase{val: ia, sql: ` INTERSECT ALL (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: e, sql: ` EXCEPT (SELECT * FROM "b")`},
+		expressionTestCase{val: e, sql: ` EXCEPT (SELECT * FROM "b")`, isPrepared: true},
+
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL (SELECT * FROM "b")`},
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL (SELECT * FROM "b")`, isPrepared: true},
 	)
 
 	opts := sqlgen.DefaultDialectOptions()
@@ -1066,6 +1623,12 @@ func (esgs *expressionSQLGeneratorSuite) TestGenerate_CompoundExpression() {
 
 		expressionTestCase{val: ia, sql: ` INTERSECT ALL SELECT * FROM "b"`},
 		expressionTestCase{val: ia, sql: ` INTERSECT ALL SELECT * FROM "b"`, isPrepared: true},
+
+		expressionTestCase{val: e, sql: ` EXCEPT SELECT * FROM "b"`},
+		expressionTestCase{val: e, sql: ` EXCEPT SELECT * FROM "b"`, isPrepared: true},
+
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL SELECT * FROM "b"`},
+		expressionTestCase{val: ea, sql: ` EXCEPT ALL SELECT * FROM "b"`, isPrepared: true},
 	)
 }
 
@@ -1,11 +1,13 @@
 package sqlgen
 
 import (
+	"reflect"
 	"strings"
 
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/doug-martin/goqu/v9/internal/errors"
 	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/internal/util"
 )
 
 type (
@@ -26,6 +28,10 @@ type (
 var (
 	ErrConflictUpdateValuesRequired = errors.New("values are required for on conflict update expression")
 	ErrNoSourceForInsert            = errors.New("no source found when generating insert sql")
+
+	ErrConflictTargetColumnsRequired = errors.New("columns are required for on conflict target")
+	ErrConflictConstraintWithWhere   = errors.New("on conflict target with a constraint cannot have a where clause")
+	ErrUpsertColumnsRequired         = errors.New("inserted columns are required to derive an upsert update")
 )
 
 func errMisMatchedRowLength(expectedL, actualL int) error {
@@ -33,7 +39,15 @@ func errMisMatchedRowLength(expectedL, actualL int) error {
 }
 
 func errUpsertWithWhereNotSupported(dialect string) error {
-	return errors.New("dialect does not support upsert with where clause [dialect=%s]", dialect)
+	return errors.NewUnsupportedFeatureError("dialect does not support upsert with where clause [dialect=%s]", dialect)
+}
+
+func errUnsupportedUpsertColumn(col exp.Expression) error {
+	return errors.New("unable to derive upsert update for column type %T", col)
+}
+
+func errConflictConstraintNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support on conflict on constraint [dialect=%s]", dialect)
 }
 
 func NewInsertSQLGenerator(dialect string, do *SQLDialectOptions) InsertSQLGenerator {
@@ -104,7 +118,7 @@ func (isg *insertSQLGenerator) InsertSQL(b sb.SQLBuilder, ic exp.InsertClauses)
 		b.Write(isg.DialectOptions().AsFragment)
 		isg.ExpressionSQLGenerator().Generate(b, ic.Alias())
 	}
-	isg.onConflictSQL(b, ic.OnConflict())
+	isg.onConflictSQL(b, ic)
 }
 
 func (isg *insertSQLGenerator) InsertExpressionSQL(b sb.SQLBuilder, ie exp.InsertExpression) {
@@ -154,7 +168,8 @@ func (isg *insertSQLGenerator) insertValuesSQL(b sb.SQLBuilder, values [][]inter
 }
 
 // Adds the DefaultValuesFragment to an SQL statement
-func (isg *insertSQLGenerator) onConflictSQL(b sb.SQLBuilder, o exp.ConflictExpression) {
+func (isg *insertSQLGenerator) onConflictSQL(b sb.SQLBuilder, ic exp.InsertClauses) {
+	o := ic.OnConflict()
 	if o == nil {
 		return
 	}
@@ -162,7 +177,11 @@ func (isg *insertSQLGenerator) onConflictSQL(b sb.SQLBuilder, o exp.ConflictExpr
 	switch t := o.(type) {
 	case exp.ConflictUpdateExpression:
 		target := t.TargetColumn()
-		if isg.DialectOptions().SupportsConflictTarget && target != "" {
+		if ct := t.Target(); ct != nil {
+			if isg.DialectOptions().SupportsConflictTarget {
+				isg.conflictTargetSQL(b, ct)
+			}
+		} else if isg.DialectOptions().SupportsConflictTarget && target != "" {
 			wrapParens := !strings.HasPrefix(strings.ToLower(target), "on constraint")
 
 			b.WriteRunes(isg.DialectOptions().SpaceRune)
@@ -174,20 +193,56 @@ func (isg *insertSQLGenerator) onConflictSQL(b sb.SQLBuilder, o exp.ConflictExpr
 				b.Write([]byte(target))
 			}
 		}
-		isg.onConflictDoUpdateSQL(b, t)
+		isg.onConflictDoUpdateSQL(b, t, ic)
 	default:
 		b.Write(isg.DialectOptions().ConflictDoNothingFragment)
 	}
 }
 
-func (isg *insertSQLGenerator) onConflictDoUpdateSQL(b sb.SQLBuilder, o exp.ConflictUpdateExpression) {
-	b.Write(isg.DialectOptions().ConflictDoUpdateFragment)
-	update := o.Update()
-	if update == nil {
-		b.SetError(ErrConflictUpdateValuesRequired)
+// Adds a structured conflict target (e.g. ("a", "b") WHERE ("c" IS NULL) or ON CONSTRAINT "a_key")
+func (isg *insertSQLGenerator) conflictTargetSQL(b sb.SQLBuilder, ct exp.ConflictTarget) {
+	if ct.IsConstraint() {
+		if !isg.DialectOptions().SupportsConflictConstraint {
+			b.SetError(errConflictConstraintNotSupported(isg.Dialect()))
+			return
+		}
+		if ct.WhereClause() != nil && !ct.WhereClause().IsEmpty() {
+			b.SetError(ErrConflictConstraintWithWhere)
+			return
+		}
+		b.Write(isg.DialectOptions().OnConstraintFragment)
+		isg.ExpressionSQLGenerator().Generate(b, exp.NewIdentifierExpression("", "", ct.Constraint()))
+		return
+	}
+	if ct.Columns() == nil || ct.Columns().IsEmpty() {
+		b.SetError(ErrConflictTargetColumnsRequired)
 		return
 	}
-	ue, err := exp.NewUpdateExpressions(update)
+	b.WriteRunes(isg.DialectOptions().SpaceRune, isg.DialectOptions().LeftParenRune)
+	isg.ExpressionSQLGenerator().Generate(b, ct.Columns())
+	b.WriteRunes(isg.DialectOptions().RightParenRune)
+	if ct.WhereClause() != nil && !ct.WhereClause().IsEmpty() {
+		isg.WhereSQL(b, ct.WhereClause())
+	}
+}
+
+func (isg *insertSQLGenerator) onConflictDoUpdateSQL(
+	b sb.SQLBuilder,
+	o exp.ConflictUpdateExpression,
+	ic exp.InsertClauses,
+) {
+	b.Write(isg.DialectOptions().ConflictDoUpdateFragment)
+	var ue []exp.UpdateExpression
+	var err error
+	if o.UpdateFromInsert() {
+		ue, err = isg.upsertUpdateExpressions(o, ic)
+	} else {
+		if o.Update() == nil {
+			b.SetError(ErrConflictUpdateValuesRequired)
+			return
+		}
+		ue, err = exp.NewUpdateExpressions(o.Update())
+	}
 	if err != nil {
 		b.SetError(err)
 		return
@@ -201,3 +256,80 @@ func (isg *insertSQLGenerator) onConflictDoUpdateSQL(b sb.SQLBuilder, o exp.Conf
 		isg.WhereSQL(b, o.WhereClause())
 	}
 }
+
+// Derives the updates for an upsert from the inserted columns. Each column is set to the value proposed for insertion,
+// skipping the conflict target columns and any struct fields tagged with goqu:"skipupdate"
+func (isg *insertSQLGenerator) upsertUpdateExpressions(
+	o exp.ConflictUpdateExpression,
+	ic exp.InsertClauses,
+) ([]exp.UpdateExpression, error) {
+	cols := ic.Cols()
+	skip := map[string]bool{}
+	if ic.HasRows() {
+		ie, err := exp.NewInsertExpression(ic.Rows()...)
+		if err != nil {
+			return nil, err
+		}
+		cols = ie.Cols()
+		if err := addSkipUpdateColumns(skip, ic.Rows()); err != nil {
+			return nil, err
+		}
+	}
+	if ct := o.Target(); ct != nil && ct.Columns() != nil {
+		for _, col := range ct.Columns().Columns() {
+			if name, ok := upsertColumnName(col); ok {
+				skip[name] = true
+			}
+		}
+	}
+	if cols == nil || cols.IsEmpty() {
+		return nil, ErrUpsertColumnsRequired
+	}
+	ues := make([]exp.UpdateExpression, 0, len(cols.Columns()))
+	for _, col := range cols.Columns() {
+		name, ok := upsertColumnName(col)
+		if !ok {
+			return nil, errUnsupportedUpsertColumn(col)
+		}
+		if skip[name] {
+			continue
+		}
+		ues = append(ues, exp.NewIdentifierExpression("", "", name).Set(exp.NewExcludedExpression(name)))
+	}
+	if len(ues) == 0 {
+		return nil, ErrConflictUpdateValuesRequired
+	}
+	return ues, nil
+}
+
+func upsertColumnName(col exp.Expression) (string, bool) {
+	if ie, ok := col.(exp.IdentifierExpression); ok {
+		name, ok := ie.GetCol().(string)
+		return name, ok
+	}
+	return "", false
+}
+
+// Adds the columns of any struct fields tagged with goqu:"skipupdate" to the skip set
+func addSkipUpdateColumns(skip map[string]bool, rows []interface{}) error {
+	row := reflect.Indirect(reflect.ValueOf(rows[0]))
+	if row.Kind() == reflect.Slice {
+		if row.Len() == 0 {
+			return nil
+		}
+		row = reflect.Indirect(row.Index(0))
+	}
+	if row.Kind() != reflect.Struct {
+		return nil
+	}
+	cm, err := util.GetColumnMap(row.Interface())
+	if err != nil {
+		return err
+	}
+	for _, cd := range cm {
+		if !cd.ShouldUpdate {
+			skip[cd.ColumnName] = true
+		}
+	}
+	return nil
+}
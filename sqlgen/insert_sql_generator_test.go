@@ -380,6 +380,166 @@ func (igs *insertSQLGeneratorSuite) TestGenerate_onConflict() {
 	)
 }
 
+func (igs *insertSQLGeneratorSuite) TestGenerate_onConflictTarget() {
+	opts := sqlgen.DefaultDialectOptions()
+
+	ic := exp.NewInsertClauses().
+		SetInto(exp.NewIdentifierExpression("", "test", "")).
+		SetCols(exp.NewColumnListExpression("a")).
+		SetVals([][]interface{}{
+			{"a1"},
+		})
+	icCols := ic.SetOnConflict(exp.NewDoUpdateConflictTargetExpression(
+		exp.NewConflictColumnsTarget("a", "b"),
+		exp.Record{"a": "b"},
+	))
+	icColsWhere := ic.SetOnConflict(exp.NewDoUpdateConflictTargetExpression(
+		exp.NewConflictColumnsTarget("a").Where(exp.NewIdentifierExpression("", "", "b").IsNull()),
+		exp.Record{"a": "b"},
+	).Where(exp.Ex{"foo": true}))
+	icConstraint := ic.SetOnConflict(exp.NewDoUpdateConflictTargetExpression(
+		exp.NewConflictConstraintTarget("test_a_key"),
+		exp.Record{"a": "b"},
+	))
+	icConstraintWhere := ic.SetOnConflict(exp.NewDoUpdateConflictTargetExpression(
+		exp.NewConflictConstraintTarget("test_a_key").Where(exp.Ex{"b": nil}),
+		exp.Record{"a": "b"},
+	))
+	icNoCols := ic.SetOnConflict(exp.NewDoUpdateConflictTargetExpression(
+		exp.NewConflictColumnsTarget(),
+		exp.Record{"a": "b"},
+	))
+
+	igs.assertCases(
+		sqlgen.NewInsertSQLGenerator("test", opts),
+		insertTestCase{
+			clause: icCols,
+			sql:    `INSERT INTO "test" ("a") VALUES ('a1') ON CONFLICT ("a", "b") DO UPDATE SET "a"='b'`,
+		},
+		insertTestCase{
+			clause:     icCols,
+			sql:        `INSERT INTO "test" ("a") VALUES (?) ON CONFLICT ("a", "b") DO UPDATE SET "a"=?`,
+			isPrepared: true,
+			args:       []interface{}{"a1", "b"},
+		},
+
+		insertTestCase{
+			clause: icColsWhere,
+			sql: `INSERT INTO "test" ("a") VALUES ('a1') ON CONFLICT ("a") WHERE ("b" IS NULL) ` +
+				`DO UPDATE SET "a"='b' WHERE ("foo" IS TRUE)`,
+		},
+		insertTestCase{
+			clause: icColsWhere,
+			sql: `INSERT INTO "test" ("a") VALUES (?) ON CONFLICT ("a") WHERE ("b" IS NULL) ` +
+				`DO UPDATE SET "a"=? WHERE ("foo" IS TRUE)`,
+			isPrepared: true,
+			args:       []interface{}{"a1", "b"},
+		},
+
+		insertTestCase{
+			clause: icConstraint,
+			sql:    `INSERT INTO "test" ("a") VALUES ('a1') ON CONFLICT ON CONSTRAINT "test_a_key" DO UPDATE SET "a"='b'`,
+		},
+		insertTestCase{
+			clause:     icConstraint,
+			sql:        `INSERT INTO "test" ("a") VALUES (?) ON CONFLICT ON CONSTRAINT "test_a_key" DO UPDATE SET "a"=?`,
+			isPrepared: true,
+			args:       []interface{}{"a1", "b"},
+		},
+
+		insertTestCase{clause: icConstraintWhere, err: sqlgen.ErrConflictConstraintWithWhere.Error()},
+		insertTestCase{clause: icConstraintWhere, err: sqlgen.ErrConflictConstraintWithWhere.Error(), isPrepared: true},
+
+		insertTestCase{clause: icNoCols, err: sqlgen.ErrConflictTargetColumnsRequired.Error()},
+		insertTestCase{clause: icNoCols, err: sqlgen.ErrConflictTargetColumnsRequired.Error(), isPrepared: true},
+	)
+
+	opts.SupportsConflictConstraint = false
+	expectedErr := "goqu: dialect does not support on conflict on constraint [dialect=test]"
+	igs.assertCases(
+		sqlgen.NewInsertSQLGenerator("test", opts),
+		insertTestCase{
+			clause: icCols,
+			sql:    `INSERT INTO "test" ("a") VALUES ('a1') ON CONFLICT ("a", "b") DO UPDATE SET "a"='b'`,
+		},
+		insertTestCase{clause: icConstraint, err: expectedErr},
+		insertTestCase{clause: icConstraint, err: expectedErr, isPrepared: true},
+	)
+
+	opts.SupportsConflictTarget = false
+	igs.assertCases(
+		sqlgen.NewInsertSQLGenerator("test", opts),
+		insertTestCase{
+			clause: icCols,
+			sql:    `INSERT INTO "test" ("a") VALUES ('a1') ON CONFLICT DO UPDATE SET "a"='b'`,
+		},
+		insertTestCase{
+			clause: icConstraint,
+			sql:    `INSERT INTO "test" ("a") VALUES ('a1') ON CONFLICT DO UPDATE SET "a"='b'`,
+		},
+	)
+}
+
+func (igs *insertSQLGeneratorSuite) TestGenerate_onConflictUpdateFromInsert() {
+	type item struct {
+		ID        int64  `db:"id"`
+		Name      string `db:"name"`
+		CreatedAt string `db:"created_at" goqu:"skipupdate"`
+	}
+	upsert := exp.NewDoUpdateFromInsertConflictExpression(exp.NewConflictColumnsTarget("id"))
+
+	ic := exp.NewInsertClauses().SetInto(exp.NewIdentifierExpression("", "test", ""))
+	icRecord := ic.SetRows([]interface{}{exp.Record{"id": 1, "name": "a", "value": "b"}}).SetOnConflict(upsert)
+	icStruct := ic.SetRows([]interface{}{[]item{{ID: 1, Name: "a", CreatedAt: "b"}}}).SetOnConflict(upsert)
+	icColsVals := ic.SetCols(exp.NewColumnListExpression("id", "name")).
+		SetVals([][]interface{}{{1, "a"}}).
+		SetOnConflict(
+			exp.NewDoUpdateFromInsertConflictExpression(exp.NewConflictColumnsTarget("id")).Where(exp.Ex{"foo": true}),
+		)
+	icConstraint := ic.SetRows([]interface{}{exp.Record{"id": 1, "name": "a"}}).SetOnConflict(
+		exp.NewDoUpdateFromInsertConflictExpression(exp.NewConflictConstraintTarget("test_pkey")),
+	)
+	icNoUpdates := ic.SetRows([]interface{}{exp.Record{"id": 1}}).SetOnConflict(upsert)
+	icNoCols := ic.SetOnConflict(upsert)
+
+	igs.assertCases(
+		sqlgen.NewInsertSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		insertTestCase{
+			clause: icRecord,
+			sql: `INSERT INTO "test" ("id", "name", "value") VALUES (1, 'a', 'b') ` +
+				`ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name","value"=EXCLUDED."value"`,
+		},
+		insertTestCase{
+			clause: icRecord,
+			sql: `INSERT INTO "test" ("id", "name", "value") VALUES (?, ?, ?) ` +
+				`ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name","value"=EXCLUDED."value"`,
+			isPrepared: true,
+			args:       []interface{}{int64(1), "a", "b"},
+		},
+
+		insertTestCase{
+			clause: icStruct,
+			sql: `INSERT INTO "test" ("created_at", "id", "name") VALUES ('b', 1, 'a') ` +
+				`ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name"`,
+		},
+
+		insertTestCase{
+			clause: icColsVals,
+			sql: `INSERT INTO "test" ("id", "name") VALUES (1, 'a') ` +
+				`ON CONFLICT ("id") DO UPDATE SET "name"=EXCLUDED."name" WHERE ("foo" IS TRUE)`,
+		},
+
+		insertTestCase{
+			clause: icConstraint,
+			sql: `INSERT INTO "test" ("id", "name") VALUES (1, 'a') ` +
+				`ON CONFLICT ON CONSTRAINT "test_pkey" DO UPDATE SET "id"=EXCLUDED."id","name"=EXCLUDED."name"`,
+		},
+
+		insertTestCase{clause: icNoUpdates, err: sqlgen.ErrConflictUpdateValuesRequired.Error()},
+		insertTestCase{clause: icNoCols, err: sqlgen.ErrUpsertColumnsRequired.Error()},
+	)
+}
+
 func (igs *insertSQLGeneratorSuite) TestGenerate_withCommonTables() {
 	opts := sqlgen.DefaultDialectOptions()
 	opts.WithFragment = []byte("with ")
@@ -0,0 +1,173 @@
+package sqlgen
+
+import (
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+)
+
+type (
+	// An adapter interface to be used by a Dataset to generate SQL for a specific dialect.
+	// See DefaultAdapter for a concrete implementation and examples.
+	MergeSQLGenerator interface {
+		Dialect() string
+		Generate(b sb.SQLBuilder, clauses exp.MergeClauses)
+	}
+	// The default adapter. This class should be used when building a new adapter. When creating a new adapter you can
+	// either override methods, or more typically update default values.
+	// See (github.com/doug-martin/goqu/dialect/postgres)
+	mergeSQLGenerator struct {
+		CommonSQLGenerator
+	}
+)
+
+var (
+	ErrNoTargetForMerge   = errors.New("no target found when generating merge sql")
+	ErrNoSourceForMerge   = errors.New("no source found when generating merge sql")
+	ErrNoOnConditionMerge = errors.New("no ON condition found when generating merge sql")
+	ErrNoWhenClausesMerge = errors.New("no WHEN clauses found when generating merge sql")
+	ErrInvalidMergeInsert = errors.New("merge INSERT action requires a single row of values")
+)
+
+func ErrMergeNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support MERGE statements [dialect=%s]", dialect)
+}
+
+func ErrMergeDoNothingNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support DO NOTHING in MERGE statements [dialect=%s]", dialect)
+}
+
+func errInvalidMergeAction(whenType exp.MergeWhenType, actionType exp.MergeActionType) error {
+	return errors.New("%s is not a valid action for %s", actionType, whenType)
+}
+
+func NewMergeSQLGenerator(dialect string, do *SQLDialectOptions) MergeSQLGenerator {
+	return &mergeSQLGenerator{NewCommonSQLGenerator(dialect, do)}
+}
+
+func (msg *mergeSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.MergeClauses) {
+	if !msg.DialectOptions().SupportsMerge {
+		b.SetError(ErrMergeNotSupported(msg.Dialect()))
+		return
+	}
+	switch {
+	case !clauses.HasInto():
+		b.SetError(ErrNoTargetForMerge)
+		return
+	case !clauses.HasUsing():
+		b.SetError(ErrNoSourceForMerge)
+		return
+	case clauses.On() == nil || clauses.On().IsEmpty():
+		b.SetError(ErrNoOnConditionMerge)
+		return
+	case len(clauses.Whens()) == 0:
+		b.SetError(ErrNoWhenClausesMerge)
+		return
+	}
+	for _, f := range msg.DialectOptions().MergeSQLOrder {
+		if b.Error() != nil {
+			return
+		}
+		switch f {
+		case CommonTableSQLFragment:
+			msg.ExpressionSQLGenerator().Generate(b, clauses.CommonTables())
+		case MergeBeginSQLFragment:
+			msg.MergeBeginSQL(b, clauses.Into())
+		case MergeUsingSQLFragment:
+			b.Write(msg.DialectOptions().UsingFragment)
+			msg.ExpressionSQLGenerator().Generate(b, clauses.Using())
+		case MergeOnSQLFragment:
+			b.Write(msg.DialectOptions().OnFragment)
+			msg.ExpressionSQLGenerator().Generate(b, clauses.On())
+		case MergeWhenSQLFragment:
+			for _, when := range clauses.Whens() {
+				msg.MergeWhenSQL(b, when)
+			}
+		default:
+			b.SetError(ErrNotSupportedFragment("MERGE", f))
+		}
+	}
+	b.Write(msg.DialectOptions().MergeEndFragment)
+}
+
+// Adds the correct fragment to being a MERGE statement
+func (msg *mergeSQLGenerator) MergeBeginSQL(b sb.SQLBuilder, target exp.Expression) {
+	b.Write(msg.DialectOptions().MergeClause)
+	b.WriteRunes(msg.DialectOptions().SpaceRune)
+	msg.ExpressionSQLGenerator().Generate(b, target)
+}
+
+// Adds a WHEN [NOT] MATCHED [AND condition] THEN action clause
+func (msg *mergeSQLGenerator) MergeWhenSQL(b sb.SQLBuilder, when exp.MergeWhenExpression) {
+	if b.Error() != nil {
+		return
+	}
+	action := when.Action()
+	switch when.Type() {
+	case exp.WhenMatchedMergeType:
+		if action.Type() == exp.InsertMergeAction {
+			b.SetError(errInvalidMergeAction(when.Type(), action.Type()))
+			return
+		}
+		b.Write(msg.DialectOptions().MergeMatchedFragment)
+	case exp.WhenNotMatchedMergeType:
+		if action.Type() != exp.InsertMergeAction && action.Type() != exp.DoNothingMergeAction {
+			b.SetError(errInvalidMergeAction(when.Type(), action.Type()))
+			return
+		}
+		b.Write(msg.DialectOptions().MergeNotMatchedFragment)
+	}
+	if where := action.WhereClause(); where != nil && !where.IsEmpty() {
+		b.Write(msg.DialectOptions().AndFragment)
+		msg.ExpressionSQLGenerator().Generate(b, where)
+	}
+	b.Write(msg.DialectOptions().ThenFragment)
+	msg.mergeActionSQL(b, action)
+}
+
+func (msg *mergeSQLGenerator) mergeActionSQL(b sb.SQLBuilder, action exp.MergeAction) {
+	switch action.Type() {
+	case exp.UpdateMergeAction:
+		updates, err := exp.NewUpdateExpressions(action.Values())
+		if err != nil {
+			b.SetError(err)
+			return
+		}
+		b.Write(msg.DialectOptions().UpdateClause)
+		b.Write(msg.DialectOptions().SetFragment)
+		msg.UpdateExpressionSQL(b, updates...)
+	case exp.DeleteMergeAction:
+		b.Write(msg.DialectOptions().DeleteClause)
+	case exp.InsertMergeAction:
+		msg.mergeInsertSQL(b, action.Values())
+	case exp.DoNothingMergeAction:
+		if !msg.DialectOptions().SupportsMergeDoNothing {
+			b.SetError(ErrMergeDoNothingNotSupported(msg.Dialect()))
+			return
+		}
+		b.Write(msg.DialectOptions().MergeDoNothingFragment)
+	}
+}
+
+func (msg *mergeSQLGenerator) mergeInsertSQL(b sb.SQLBuilder, values interface{}) {
+	if values == nil {
+		b.Write(msg.DialectOptions().MergeInsertFragment)
+		b.Write(msg.DialectOptions().MergeInsertDefaultValuesFragment)
+		return
+	}
+	ie, err := exp.NewInsertExpression(values)
+	if err != nil {
+		b.SetError(err)
+		return
+	}
+	if ie.IsInsertFrom() || len(ie.Vals()) != 1 {
+		b.SetError(ErrInvalidMergeInsert)
+		return
+	}
+	b.Write(msg.DialectOptions().MergeInsertFragment)
+	b.WriteRunes(msg.DialectOptions().SpaceRune, msg.DialectOptions().LeftParenRune)
+	msg.ExpressionSQLGenerator().Generate(b, ie.Cols())
+	b.WriteRunes(msg.DialectOptions().RightParenRune)
+	b.Write(msg.DialectOptions().ValuesFragment)
+	msg.ExpressionSQLGenerator().Generate(b, ie.Vals()[0])
+}
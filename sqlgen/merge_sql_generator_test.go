@@ -0,0 +1,324 @@
+package sqlgen_test
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/internal/sb"
+	"github.com/doug-martin/goqu/v9/sqlgen"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	mergeTestCase struct {
+		clause     exp.MergeClauses
+		sql        string
+		isPrepared bool
+		args       []interface{}
+		err        string
+	}
+	mergeSQLGeneratorSuite struct {
+		baseSQLGeneratorSuite
+	}
+)
+
+func (msgs *mergeSQLGeneratorSuite) assertCases(msg sqlgen.MergeSQLGenerator, testCases ...mergeTestCase) {
+	for _, tc := range testCases {
+		b := sb.NewSQLBuilder(tc.isPrepared)
+		msg.Generate(b, tc.clause)
+		switch {
+		case len(tc.err) > 0:
+			msgs.assertErrorSQL(b, tc.err)
+		case tc.isPrepared:
+			msgs.assertPreparedSQL(b, tc.sql, tc.args)
+		default:
+			msgs.assertNotPreparedSQL(b, tc.sql)
+		}
+	}
+}
+
+func (msgs *mergeSQLGeneratorSuite) baseClauses() exp.MergeClauses {
+	return exp.NewMergeClauses().
+		SetInto(exp.NewIdentifierExpression("", "test", "")).
+		SetUsing(exp.NewIdentifierExpression("", "other", "")).
+		OnAppend(exp.NewIdentifierExpression("", "test", "id").Eq(exp.NewIdentifierExpression("", "other", "id")))
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestDialect() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewMergeSQLGenerator("test", opts)
+	msgs.Equal("test", d.Dialect())
+
+	opts2 := sqlgen.DefaultDialectOptions()
+	d2 := sqlgen.NewMergeSQLGenerator("test2", opts2)
+	msgs.Equal("test2", d2.Dialect())
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate() {
+	mc := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(
+			exp.WhenMatchedMergeType,
+			exp.NewMergeAction(exp.UpdateMergeAction, exp.Record{"name": exp.NewIdentifierExpression("", "other", "name")}),
+		),
+		exp.NewMergeWhenExpression(
+			exp.WhenNotMatchedMergeType,
+			exp.NewMergeAction(exp.InsertMergeAction, exp.Record{"id": exp.NewIdentifierExpression("", "other", "id"), "name": "a"}),
+		),
+	)
+
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		mergeTestCase{
+			clause: mc,
+			sql: `MERGE INTO "test" USING "other" ON ("test"."id" = "other"."id") ` +
+				`WHEN MATCHED THEN UPDATE SET "name"="other"."name" ` +
+				`WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("other"."id", 'a')`,
+		},
+		mergeTestCase{
+			clause: mc,
+			sql: `MERGE INTO "test" USING "other" ON ("test"."id" = "other"."id") ` +
+				`WHEN MATCHED THEN UPDATE SET "name"="other"."name" ` +
+				`WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("other"."id", ?)`,
+			isPrepared: true,
+			args:       []interface{}{"a"},
+		},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MergeClause = []byte("merge into")
+	opts.MergeEndFragment = []byte(";")
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{
+			clause: mc,
+			sql: `merge into "test" USING "other" ON ("test"."id" = "other"."id") ` +
+				`WHEN MATCHED THEN UPDATE SET "name"="other"."name" ` +
+				`WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("other"."id", 'a');`,
+		},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_unsupported() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsMerge = false
+	mc := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, exp.NewMergeAction(exp.DeleteMergeAction, nil)),
+	)
+	expectedErr := sqlgen.ErrMergeNotSupported("test")
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{clause: mc, err: expectedErr.Error()},
+		mergeTestCase{clause: mc, err: expectedErr.Error(), isPrepared: true},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_withUnsupportedFragment() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.MergeSQLOrder = []sqlgen.SQLFragmentType{sqlgen.InsertBeingSQLFragment}
+	mc := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, exp.NewMergeAction(exp.DeleteMergeAction, nil)),
+	)
+
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{clause: mc, err: `goqu: unsupported MERGE SQL fragment InsertBeingSQLFragment`},
+		mergeTestCase{clause: mc, err: `goqu: unsupported MERGE SQL fragment InsertBeingSQLFragment`, isPrepared: true},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_missingClauses() {
+	when := exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, exp.NewMergeAction(exp.DeleteMergeAction, nil))
+	on := exp.NewIdentifierExpression("", "test", "id").Eq(exp.NewIdentifierExpression("", "other", "id"))
+
+	noInto := exp.NewMergeClauses().
+		SetUsing(exp.NewIdentifierExpression("", "other", "")).
+		OnAppend(on).
+		WhensAppend(when)
+	noUsing := exp.NewMergeClauses().
+		SetInto(exp.NewIdentifierExpression("", "test", "")).
+		OnAppend(on).
+		WhensAppend(when)
+	noOn := exp.NewMergeClauses().
+		SetInto(exp.NewIdentifierExpression("", "test", "")).
+		SetUsing(exp.NewIdentifierExpression("", "other", "")).
+		WhensAppend(when)
+	noWhens := msgs.baseClauses()
+
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		mergeTestCase{clause: noInto, err: sqlgen.ErrNoTargetForMerge.Error()},
+		mergeTestCase{clause: noInto, err: sqlgen.ErrNoTargetForMerge.Error(), isPrepared: true},
+
+		mergeTestCase{clause: noUsing, err: sqlgen.ErrNoSourceForMerge.Error()},
+		mergeTestCase{clause: noUsing, err: sqlgen.ErrNoSourceForMerge.Error(), isPrepared: true},
+
+		mergeTestCase{clause: noOn, err: sqlgen.ErrNoOnConditionMerge.Error()},
+		mergeTestCase{clause: noOn, err: sqlgen.ErrNoOnConditionMerge.Error(), isPrepared: true},
+
+		mergeTestCase{clause: noWhens, err: sqlgen.ErrNoWhenClausesMerge.Error()},
+		mergeTestCase{clause: noWhens, err: sqlgen.ErrNoWhenClausesMerge.Error(), isPrepared: true},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_withErroredBuilder() {
+	opts := sqlgen.DefaultDialectOptions()
+	d := sqlgen.NewMergeSQLGenerator("test", opts)
+
+	mc := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, exp.NewMergeAction(exp.DeleteMergeAction, nil)),
+	)
+	b := sb.NewSQLBuilder(false).SetError(errors.New("expected error"))
+	d.Generate(b, mc)
+	msgs.assertErrorSQL(b, "goqu: expected error")
+
+	b = sb.NewSQLBuilder(true).SetError(errors.New("expected error"))
+	d.Generate(b, mc)
+	msgs.assertErrorSQL(b, "goqu: expected error")
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_withCommonTables() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.WithFragment = []byte("with ")
+	opts.RecursiveFragment = []byte("recursive ")
+
+	tse := newTestAppendableExpression("select * from foo", emptyArgs, nil, nil)
+
+	mc := exp.NewMergeClauses().
+		SetInto(exp.NewIdentifierExpression("", "test", "")).
+		SetUsing(exp.NewIdentifierExpression("", "test_cte", "")).
+		OnAppend(exp.NewIdentifierExpression("", "test", "id").Eq(exp.NewIdentifierExpression("", "test_cte", "id"))).
+		WhensAppend(
+			exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, exp.NewMergeAction(exp.DeleteMergeAction, nil)),
+		)
+	mcCte1 := mc.CommonTablesAppend(exp.NewCommonTableExpression(false, "test_cte", tse))
+	mcCte2 := mc.CommonTablesAppend(exp.NewCommonTableExpression(true, "test_cte", tse))
+
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{
+			clause: mcCte1,
+			sql: `with test_cte AS (select * from foo) MERGE INTO "test" USING "test_cte" ` +
+				`ON ("test"."id" = "test_cte"."id") WHEN MATCHED THEN DELETE`,
+		},
+		mergeTestCase{
+			clause: mcCte2,
+			sql: `with recursive test_cte AS (select * from foo) MERGE INTO "test" USING "test_cte" ` +
+				`ON ("test"."id" = "test_cte"."id") WHEN MATCHED THEN DELETE`,
+			isPrepared: true,
+		},
+	)
+
+	opts.SupportsWithCTE = false
+	expectedErr := sqlgen.ErrCTENotSupported("test")
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{clause: mcCte1, err: expectedErr.Error()},
+		mergeTestCase{clause: mcCte1, err: expectedErr.Error(), isPrepared: true},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_withConditionalActions() {
+	mc := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(
+			exp.WhenMatchedMergeType,
+			exp.NewMergeAction(exp.DeleteMergeAction, nil).
+				Where(exp.NewIdentifierExpression("", "other", "deleted").IsTrue()),
+		),
+		exp.NewMergeWhenExpression(
+			exp.WhenMatchedMergeType,
+			exp.NewMergeAction(exp.UpdateMergeAction, exp.Record{"count": 1}).
+				Where(exp.NewIdentifierExpression("", "other", "count").Gt(10)),
+		),
+		exp.NewMergeWhenExpression(exp.WhenNotMatchedMergeType, exp.NewMergeAction(exp.InsertMergeAction, nil)),
+	)
+
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		mergeTestCase{
+			clause: mc,
+			sql: `MERGE INTO "test" USING "other" ON ("test"."id" = "other"."id") ` +
+				`WHEN MATCHED AND ("other"."deleted" IS TRUE) THEN DELETE ` +
+				`WHEN MATCHED AND ("other"."count" > 10) THEN UPDATE SET "count"=1 ` +
+				`WHEN NOT MATCHED THEN INSERT DEFAULT VALUES`,
+		},
+		mergeTestCase{
+			clause: mc,
+			sql: `MERGE INTO "test" USING "other" ON ("test"."id" = "other"."id") ` +
+				`WHEN MATCHED AND ("other"."deleted" IS TRUE) THEN DELETE ` +
+				`WHEN MATCHED AND ("other"."count" > ?) THEN UPDATE SET "count"=? ` +
+				`WHEN NOT MATCHED THEN INSERT DEFAULT VALUES`,
+			isPrepared: true,
+			args:       []interface{}{int64(10), int64(1)},
+		},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_withDoNothing() {
+	mc := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, exp.NewMergeAction(exp.DoNothingMergeAction, nil)),
+		exp.NewMergeWhenExpression(exp.WhenNotMatchedMergeType, exp.NewMergeAction(exp.DoNothingMergeAction, nil)),
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{
+			clause: mc,
+			sql: `MERGE INTO "test" USING "other" ON ("test"."id" = "other"."id") ` +
+				`WHEN MATCHED THEN DO NOTHING WHEN NOT MATCHED THEN DO NOTHING`,
+		},
+		mergeTestCase{
+			clause: mc,
+			sql: `MERGE INTO "test" USING "other" ON ("test"."id" = "other"."id") ` +
+				`WHEN MATCHED THEN DO NOTHING WHEN NOT MATCHED THEN DO NOTHING`,
+			isPrepared: true,
+		},
+	)
+
+	opts.SupportsMergeDoNothing = false
+	expectedErr := sqlgen.ErrMergeDoNothingNotSupported("test")
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", opts),
+		mergeTestCase{clause: mc, err: expectedErr.Error()},
+		mergeTestCase{clause: mc, err: expectedErr.Error(), isPrepared: true},
+	)
+}
+
+func (msgs *mergeSQLGeneratorSuite) TestGenerate_withInvalidActions() {
+	matchedInsert := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, exp.NewMergeAction(exp.InsertMergeAction, nil)),
+	)
+	notMatchedUpdate := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(
+			exp.WhenNotMatchedMergeType,
+			exp.NewMergeAction(exp.UpdateMergeAction, exp.Record{"a": 1}),
+		),
+	)
+	notMatchedDelete := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(exp.WhenNotMatchedMergeType, exp.NewMergeAction(exp.DeleteMergeAction, nil)),
+	)
+	multiInsert := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(
+			exp.WhenNotMatchedMergeType,
+			exp.NewMergeAction(exp.InsertMergeAction, []exp.Record{{"a": 1}, {"a": 2}}),
+		),
+	)
+	invalidUpdate := msgs.baseClauses().WhensAppend(
+		exp.NewMergeWhenExpression(exp.WhenMatchedMergeType, exp.NewMergeAction(exp.UpdateMergeAction, true)),
+	)
+
+	msgs.assertCases(
+		sqlgen.NewMergeSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		mergeTestCase{clause: matchedInsert, err: "goqu: INSERT is not a valid action for WHEN MATCHED"},
+		mergeTestCase{clause: notMatchedUpdate, err: "goqu: UPDATE is not a valid action for WHEN NOT MATCHED"},
+		mergeTestCase{clause: notMatchedDelete, err: "goqu: DELETE is not a valid action for WHEN NOT MATCHED"},
+		mergeTestCase{clause: multiInsert, err: sqlgen.ErrInvalidMergeInsert.Error()},
+		mergeTestCase{clause: multiInsert, err: sqlgen.ErrInvalidMergeInsert.Error(), isPrepared: true},
+		mergeTestCase{clause: invalidUpdate, err: "goqu: unsupported update interface type bool"},
+	)
+}
+
+func TestMergeSQLGenerator(t *testing.T) {
+	suite.Run(t, new(mergeSQLGeneratorSuite))
+}
@@ -0,0 +1,31 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import exp "github.com/doug-martin/goqu/v9/exp"
+import mock "github.com/stretchr/testify/mock"
+import sb "github.com/doug-martin/goqu/v9/internal/sb"
+
+// MergeSQLGenerator is an autogenerated mock type for the MergeSQLGenerator type
+type MergeSQLGenerator struct {
+	mock.Mock
+}
+
+// Dialect provides a mock function with given fields:
+func (_m *MergeSQLGenerator) Dialect() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Generate provides a mock function with given fields: b, clauses
+func (_m *MergeSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.MergeClauses) {
+	_m.Called(b, clauses)
+}
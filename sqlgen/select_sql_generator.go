@@ -22,7 +22,7 @@ type (
 )
 
 func ErrNotSupportedJoinType(j exp.JoinExpression) error {
-	return errors.New("dialect does not support %v", j.JoinType())
+	return errors.NewUnsupportedFeatureError("dialect does not support %v", j.JoinType())
 }
 
 func ErrJoinConditionRequired(j exp.JoinExpression) error {
@@ -30,11 +30,25 @@ func ErrJoinConditionRequired(j exp.JoinExpression) error {
 }
 
 func ErrDistinctOnNotSupported(dialect string) error {
-	return errors.New("dialect does not support DISTINCT ON clause [dialect=%s]", dialect)
+	return errors.NewUnsupportedFeatureError("dialect does not support DISTINCT ON clause [dialect=%s]", dialect)
 }
 
 func ErrWindowNotSupported(dialect string) error {
-	return errors.New("dialect does not support WINDOW clause [dialect=%s]", dialect)
+	return errors.NewUnsupportedFeatureError("dialect does not support WINDOW clause [dialect=%s]", dialect)
+}
+
+func ErrExceptNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support EXCEPT clause [dialect=%s]", dialect)
+}
+
+func ErrExceptAllNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError("dialect does not support EXCEPT ALL clause [dialect=%s]", dialect)
+}
+
+func ErrWithRollupNotSupported(dialect string) error {
+	return errors.NewUnsupportedFeatureError(
+		"dialect only supports a single ROLLUP of columns in a GROUP BY clause [dialect=%s]", dialect,
+	)
 }
 
 var ErrNoWindowName = errors.New("window expresion has no valid name")
@@ -151,11 +165,46 @@ func (ssg *selectSQLGenerator) JoinSQL(b sb.SQLBuilder, joins exp.JoinExpression
 // Generates the GROUP BY clause for an SQL statement
 func (ssg *selectSQLGenerator) GroupBySQL(b sb.SQLBuilder, groupBy exp.ColumnListExpression) {
 	if groupBy != nil && len(groupBy.Columns()) > 0 {
+		if ssg.DialectOptions().UseWithRollupSyntax && hasGroupingSet(groupBy) {
+			ssg.withRollupSQL(b, groupBy)
+			return
+		}
 		b.Write(ssg.DialectOptions().GroupByFragment)
 		ssg.ExpressionSQLGenerator().Generate(b, groupBy)
 	}
 }
 
+// Generates GROUP BY ... WITH ROLLUP for dialects that do not support ROLLUP(...). The ROLLUP must be the only
+// expression in the GROUP BY and may only contain single columns.
+func (ssg *selectSQLGenerator) withRollupSQL(b sb.SQLBuilder, groupBy exp.ColumnListExpression) {
+	cols := groupBy.Columns()
+	gs, ok := cols[0].(exp.GroupingSetExpression)
+	if len(cols) != 1 || !ok || gs.Type() != exp.RollupGroupingSetType {
+		b.SetError(ErrWithRollupNotSupported(ssg.Dialect()))
+		return
+	}
+	rollupCols := make([]interface{}, 0, len(gs.Elements()))
+	for _, element := range gs.Elements() {
+		if len(element.Columns()) != 1 {
+			b.SetError(ErrWithRollupNotSupported(ssg.Dialect()))
+			return
+		}
+		rollupCols = append(rollupCols, element.Columns()[0])
+	}
+	b.Write(ssg.DialectOptions().GroupByFragment)
+	ssg.ExpressionSQLGenerator().Generate(b, exp.NewColumnListExpression(rollupCols...))
+	b.Write(ssg.DialectOptions().WithRollupFragment)
+}
+
+func hasGroupingSet(groupBy exp.ColumnListExpression) bool {
+	for _, col := range groupBy.Columns() {
+		if _, ok := col.(exp.GroupingSetExpression); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // Generates the HAVING clause for an SQL statement
 func (ssg *selectSQLGenerator) HavingSQL(b sb.SQLBuilder, having exp.ExpressionList) {
 	if having != nil && len(having.Expressions()) > 0 {
@@ -175,6 +224,14 @@ func (ssg *selectSQLGenerator) OffsetSQL(b sb.SQLBuilder, offset uint) {
 // Generates the compound sql clause for an SQL statement (e.g. UNION, INTERSECT)
 func (ssg *selectSQLGenerator) CompoundsSQL(b sb.SQLBuilder, compounds []exp.CompoundExpression) {
 	for _, compound := range compounds {
+		switch {
+		case compound.Type() == exp.ExceptCompoundType && !ssg.DialectOptions().SupportsExcept:
+			b.SetError(ErrExceptNotSupported(ssg.Dialect()))
+			return
+		case compound.Type() == exp.ExceptAllCompoundType && !ssg.DialectOptions().SupportsExceptAll:
+			b.SetError(ErrExceptAllNotSupported(ssg.Dialect()))
+			return
+		}
 		ssg.ExpressionSQLGenerator().Generate(b, compound)
 	}
 }
@@ -306,6 +306,39 @@ func (ssgs *selectSQLGeneratorSuite) TestGenerate_withGroupBy() {
 	)
 }
 
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withGroupByWithRollup() {
+	opts := sqlgen.DefaultDialectOptions()
+	opts.UseWithRollupSyntax = true
+	opts.WithRollupFragment = []byte(" with rollup")
+
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test"))
+	scGroup := sc.SetGroupBy(exp.NewColumnListExpression("a"))
+	scRollup := sc.SetGroupBy(exp.NewColumnListExpression(
+		exp.NewGroupingSetExpression(exp.RollupGroupingSetType, "a", "b"),
+	))
+	scRollupMixed := sc.SetGroupBy(exp.NewColumnListExpression(
+		"a", exp.NewGroupingSetExpression(exp.RollupGroupingSetType, "b"),
+	))
+	scRollupComposite := sc.SetGroupBy(exp.NewColumnListExpression(
+		exp.NewGroupingSetExpression(exp.RollupGroupingSetType, "a", []string{"b", "c"}),
+	))
+	scCube := sc.SetGroupBy(exp.NewColumnListExpression(
+		exp.NewGroupingSetExpression(exp.CubeGroupingSetType, "a", "b"),
+	))
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scGroup, sql: `SELECT * FROM "test" GROUP BY "a"`},
+
+		selectTestCase{clause: scRollup, sql: `SELECT * FROM "test" GROUP BY "a", "b" with rollup`},
+		selectTestCase{clause: scRollup, sql: `SELECT * FROM "test" GROUP BY "a", "b" with rollup`, isPrepared: true},
+
+		selectTestCase{clause: scRollupMixed, err: sqlgen.ErrWithRollupNotSupported("test").Error()},
+		selectTestCase{clause: scRollupComposite, err: sqlgen.ErrWithRollupNotSupported("test").Error()},
+		selectTestCase{clause: scCube, err: sqlgen.ErrWithRollupNotSupported("test").Error()},
+	)
+}
+
 func (ssgs *selectSQLGeneratorSuite) TestGenerate_withHaving() {
 	opts := sqlgen.DefaultDialectOptions()
 	opts.HavingFragment = []byte(" having ")
@@ -520,6 +553,45 @@ func (ssgs *selectSQLGeneratorSuite) TestGenerate_withCompounds() {
 	)
 }
 
+func (ssgs *selectSQLGeneratorSuite) TestGenerate_withExceptCompounds() {
+	tse := newTestAppendableExpression("select * from foo", emptyArgs, nil, nil)
+	sc := exp.NewSelectClauses().SetFrom(exp.NewColumnListExpression("test"))
+	scExcept := sc.CompoundsAppend(exp.NewCompoundExpression(exp.ExceptCompoundType, tse))
+	scExceptAll := sc.CompoundsAppend(exp.NewCompoundExpression(exp.ExceptAllCompoundType, tse))
+
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", sqlgen.DefaultDialectOptions()),
+		selectTestCase{clause: scExcept, sql: `SELECT * FROM "test" EXCEPT (select * from foo)`},
+		selectTestCase{clause: scExcept, sql: `SELECT * FROM "test" EXCEPT (select * from foo)`, isPrepared: true},
+
+		selectTestCase{clause: scExceptAll, sql: `SELECT * FROM "test" EXCEPT ALL (select * from foo)`},
+		selectTestCase{clause: scExceptAll, sql: `SELECT * FROM "test" EXCEPT ALL (select * from foo)`, isPrepared: true},
+	)
+
+	opts := sqlgen.DefaultDialectOptions()
+	opts.SupportsExceptAll = false
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scExcept, sql: `SELECT * FROM "test" EXCEPT (select * from foo)`},
+		selectTestCase{clause: scExcept, sql: `SELECT * FROM "test" EXCEPT (select * from foo)`, isPrepared: true},
+
+		selectTestCase{clause: scExceptAll, err: "goqu: dialect does not support EXCEPT ALL clause [dialect=test]"},
+		selectTestCase{clause: scExceptAll, err: "goqu: dialect does not support EXCEPT ALL clause [dialect=test]", isPrepared: true},
+	)
+
+	opts = sqlgen.DefaultDialectOptions()
+	opts.SupportsExcept = false
+	opts.SupportsExceptAll = false
+	ssgs.assertCases(
+		sqlgen.NewSelectSQLGenerator("test", opts),
+		selectTestCase{clause: scExcept, err: "goqu: dialect does not support EXCEPT clause [dialect=test]"},
+		selectTestCase{clause: scExcept, err: "goqu: dialect does not support EXCEPT clause [dialect=test]", isPrepared: true},
+
+		selectTestCase{clause: scExceptAll, err: "goqu: dialect does not support EXCEPT ALL clause [dialect=test]"},
+		selectTestCase{clause: scExceptAll, err: "goqu: dialect does not support EXCEPT ALL clause [dialect=test]", isPrepared: true},
+	)
+}
+
 func (ssgs *selectSQLGeneratorSuite) TestToSelectSQL_withFor() {
 	opts := sqlgen.DefaultDialectOptions()
 	opts.ForUpdateFragment = []byte(" for update ")
@@ -1,6 +1,7 @@
 package sqlgen
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"time"
 
@@ -26,6 +27,10 @@ type (
 		SupportsConflictTarget bool
 		// Set to true if the dialect supports Conflict Target (DEFAULT=true)
 		SupportsConflictUpdateWhere bool
+		// Set to true if the dialect supports ON CONSTRAINT as a Conflict Target (DEFAULT=true)
+		SupportsConflictConstraint bool
+		// Set to true if the dialect supports referencing the row proposed for insertion in an upsert (DEFAULT=true)
+		SupportsExcludedRow bool
 		// Set to true if the dialect supports Insert Ignore syntax (DEFAULT=false)
 		SupportsInsertIgnoreSyntax bool
 		// Set to true if the dialect supports Common Table Expressions (DEFAULT=true)
@@ -44,9 +49,50 @@ type (
 		// Set to true if window function are supported in SELECT statement. (DEFAULT=true)
 		SupportsWindowFunction bool
 
+		// Set to true if window frames (e.g. ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) are supported.
+		// (DEFAULT=true)
+		SupportsWindowFrame bool
+		// Set to true if the EXCLUDE option of a window frame is supported. (DEFAULT=true)
+		SupportsWindowFrameExclusion bool
+
+		// Set to true if the FILTER (WHERE ...) clause is supported on aggregate functions. If false the filter is
+		// emulated using a CASE WHEN expression inside of the aggregate, functions without arguments or with a
+		// DISTINCT argument can not be emulated and return an error. (DEFAULT=true)
+		SupportsFilterClause bool
+		// Set to true if the WITHIN GROUP (ORDER BY ...) clause is supported on aggregate functions. (DEFAULT=true)
+		SupportsWithinGroup bool
+
+		// Set to true if MERGE statements are supported. (DEFAULT=true)
+		SupportsMerge bool
+		// Set to true if the DO NOTHING action is supported in MERGE statements. (DEFAULT=true)
+		SupportsMergeDoNothing bool
+
+		// Set to true if arrays and array operators (e.g. @>) are supported. (DEFAULT=true)
+		SupportsArrays bool
+		// Set to true to send a slice used with IN or NOT IN as a single array parameter when generating a prepared
+		// statement (e.g. "a" = ANY($1)). Requires ArrayValuer to be set. (DEFAULT=false)
+		UseArrayParamsForIn bool
+
+		// Set to true if EXCEPT compound statements are supported. (DEFAULT=true)
+		SupportsExcept bool
+		// Set to true if EXCEPT ALL compound statements are supported. (DEFAULT=true)
+		SupportsExceptAll bool
+
+		// Set to true if row value comparisons (e.g. ("a", "b") > (1, 2)) are supported. When false
+		// SelectDataset#Paginate expands the seek predicate into a chain of OR conditions. (DEFAULT=true)
+		SupportsRowValueComparison bool
+
 		// Set to true if the dialect requires join tables in UPDATE to be in a FROM clause (DEFAULT=true).
 		UseFromClauseForMultipleUpdateTables bool
 
+		// Set to true if the dialect uses the GROUP BY ... WITH ROLLUP syntax instead of ROLLUP(...), like in MySQL.
+		// (DEFAULT=false)
+		UseWithRollupSyntax bool
+
+		// Set to true if the dialect uses JSON functions (e.g. JSON_EXTRACT) instead of JSON operators (e.g. ->). When
+		// true the values of JSONOperatorLookup are the names of the functions to call. (DEFAULT=false)
+		UseJSONFunctions bool
+
 		// Surround LIMIT parameter with parentheses, like in MSSQL: SELECT TOP (10) ...
 		SurroundLimitWithParentheses bool
 
@@ -60,6 +106,8 @@ type (
 		SelectClause []byte
 		// The DELETE fragment to use when generating sql. (DEFAULT=[]byte("DELETE"))
 		DeleteClause []byte
+		// The MERGE fragment to use when generating sql. (DEFAULT=[]byte("MERGE INTO"))
+		MergeClause []byte
 		// The TRUNCATE fragment to use when generating sql. (DEFAULT=[]byte("TRUNCATE"))
 		TruncateClause []byte
 		// The WITH fragment to use when generating sql. (DEFAULT=[]byte("WITH "))
@@ -105,6 +153,28 @@ type (
 		WindowOrderByFragment []byte
 		// The SQL WINDOW clause OVER fragment(DEFAULT=[]byte(" OVER "))
 		WindowOverFragment []byte
+		// The SQL BETWEEN fragment used in window frames(DEFAULT=[]byte(" BETWEEN "))
+		WindowBetweenFragment []byte
+		// The SQL FILTER fragment used for aggregate functions(DEFAULT=[]byte(" FILTER (WHERE "))
+		FilterFragment []byte
+		// The SQL WITHIN GROUP fragment used for aggregate functions(DEFAULT=[]byte(" WITHIN GROUP (ORDER BY "))
+		WithinGroupFragment []byte
+		// The SQL WITH ROLLUP fragment used when UseWithRollupSyntax is true(DEFAULT=[]byte(" WITH ROLLUP"))
+		WithRollupFragment []byte
+		// The SQL WHEN MATCHED fragment used in MERGE statements(DEFAULT=[]byte(" WHEN MATCHED"))
+		MergeMatchedFragment []byte
+		// The SQL WHEN NOT MATCHED fragment used in MERGE statements(DEFAULT=[]byte(" WHEN NOT MATCHED"))
+		MergeNotMatchedFragment []byte
+		// The SQL INSERT fragment used in MERGE statements(DEFAULT=[]byte("INSERT"))
+		MergeInsertFragment []byte
+		// The SQL DEFAULT VALUES fragment used in MERGE statements when the INSERT action does not have any values. Unlike
+		// DefaultValuesFragment this is also required by dialects that omit DEFAULT VALUES from INSERT
+		// statements(DEFAULT=[]byte(" DEFAULT VALUES"))
+		MergeInsertDefaultValuesFragment []byte
+		// The SQL DO NOTHING fragment used in MERGE statements(DEFAULT=[]byte("DO NOTHING"))
+		MergeDoNothingFragment []byte
+		// The fragment used to terminate a MERGE statement, SQL Server requires a semicolon(DEFAULT=[]byte(""))
+		MergeEndFragment []byte
 		// The SQL ORDER BY clause fragment(DEFAULT=[]byte(" ORDER BY "))
 		OrderByFragment []byte
 		// The SQL FETCH fragment(DEFAULT=[]byte(" "))
@@ -159,6 +229,10 @@ type (
 		IntersectFragment []byte
 		// The INTERSECT ALL keyword used when creating compound statements (DEFAULT=[]byte(" INTERSECT ALL "))
 		IntersectAllFragment []byte
+		// The EXCEPT keyword used when creating compound statements (DEFAULT=[]byte(" EXCEPT "))
+		ExceptFragment []byte
+		// The EXCEPT ALL keyword used when creating compound statements (DEFAULT=[]byte(" EXCEPT ALL "))
+		ExceptAllFragment []byte
 		// The CAST keyword to use when casting a value (DEFAULT=[]byte("CAST"))
 		CastFragment []byte
 		// The CASE keyword to use when when creating a CASE statement (DEFAULT=[]byte("CASE "))
@@ -195,6 +269,34 @@ type (
 		IncludePlaceholderNum bool
 		// The time format to use when serializing time.Time (DEFAULT=time.RFC3339Nano)
 		TimeFormat string
+		// The maximum number of parameters allowed in a single prepared statement. Batched inserts are split so each
+		// statement stays under this limit, 0 means there is no limit (DEFAULT=0)
+		MaxParams int
+		// The maximum number of rows allowed in the VALUES list of a single INSERT statement. Batched inserts are split
+		// so each statement stays under this limit, 0 means there is no limit (DEFAULT=0)
+		MaxInsertRows int
+		// Used to convert a slice into a single array parameter when generating a prepared statement. If nil each
+		// element is added as a separate parameter (e.g. ARRAY[?, ?]) (DEFAULT=nil)
+		ArrayValuer func(elements interface{}) driver.Valuer
+		// Used to create the statement that InsertDataset#CopyFrom prepares to bulk load rows (e.g. pq.CopyIn). The
+		// schema is empty if the table is not qualified. If nil the dialect does not support CopyFrom (DEFAULT=nil)
+		CopyInQuery func(schema, table string, columns []string) string
+		// Used by Database#WithTxRetry to determine if an error returned while executing a transaction is transient
+		// (e.g. a serialization failure or deadlock) and the transaction should be retried. If nil transactions are
+		// not retried unless TxRetryOptions.IsRetryable is set (DEFAULT=nil)
+		IsRetryableError func(err error) bool
+		// Used by goqu.IsUniqueViolation to determine if an error returned by the driver is a unique constraint
+		// violation. If nil errors from the driver of the dialect are never classified (DEFAULT=nil)
+		IsUniqueViolation func(err error) bool
+		// Used by goqu.IsForeignKeyViolation to determine if an error returned by the driver is a foreign key
+		// constraint violation. If nil errors from the driver of the dialect are never classified (DEFAULT=nil)
+		IsForeignKeyViolation func(err error) bool
+		// Used by goqu.IsNotNullViolation to determine if an error returned by the driver is a not null constraint
+		// violation. If nil errors from the driver of the dialect are never classified (DEFAULT=nil)
+		IsNotNullViolation func(err error) bool
+		// Used by RecordScanner#ScanRecord to convert the value scanned from a column with the given database type (e.g.
+		// VARCHAR) into the value stored in the record. If nil exec.ConvertColumnValue is used (DEFAULT=nil)
+		ConvertColumnValue func(databaseTypeName string, val interface{}) (interface{}, error)
 		// A map used to look up BooleanOperations and their SQL equivalents
 		// (Default= map[exp.BooleanOperation][]byte{
 		// 		exp.EqOp:             []byte("="),
@@ -249,6 +351,58 @@ type (
 		// 		exp.CrossJoinType:        []byte(" CROSS JOIN "),
 		// 	})
 		JoinTypeLookup map[exp.JoinType][]byte
+		// A map used to look up WindowFrameUnits and their SQL equivalents. If a unit is not in the map it is treated
+		// as unsupported
+		// (Default= map[exp.WindowFrameUnit][]byte{
+		// 		exp.RowsFrameUnit:   []byte("ROWS"),
+		// 		exp.RangeFrameUnit:  []byte("RANGE"),
+		// 		exp.GroupsFrameUnit: []byte("GROUPS"),
+		// 	})
+		WindowFrameUnitLookup map[exp.WindowFrameUnit][]byte
+		// A map used to look up WindowFrameBoundTypes and their SQL equivalents
+		// (Default= map[exp.WindowFrameBoundType][]byte{
+		// 		exp.UnboundedPrecedingFrameBound: []byte("UNBOUNDED PRECEDING"),
+		// 		exp.PrecedingFrameBound:          []byte(" PRECEDING"),
+		// 		exp.CurrentRowFrameBound:         []byte("CURRENT ROW"),
+		// 		exp.FollowingFrameBound:          []byte(" FOLLOWING"),
+		// 		exp.UnboundedFollowingFrameBound: []byte("UNBOUNDED FOLLOWING"),
+		// 	})
+		WindowFrameBoundLookup map[exp.WindowFrameBoundType][]byte
+		// A map used to look up WindowFrameExclusions and their SQL equivalents
+		// (Default= map[exp.WindowFrameExclusion][]byte{
+		// 		exp.ExcludeCurrentRowFrameExclusion: []byte(" EXCLUDE CURRENT ROW"),
+		// 		exp.ExcludeGroupFrameExclusion:      []byte(" EXCLUDE GROUP"),
+		// 		exp.ExcludeTiesFrameExclusion:       []byte(" EXCLUDE TIES"),
+		// 		exp.ExcludeNoOthersFrameExclusion:   []byte(" EXCLUDE NO OTHERS"),
+		// 	})
+		WindowFrameExclusionLookup map[exp.WindowFrameExclusion][]byte
+		// A map used to look up GroupingSetTypes and their SQL equivalents. Remove a type from the map if the
+		// dialect does not support it.
+		// (Default= map[exp.GroupingSetType][]byte{
+		// 		exp.RollupGroupingSetType:       []byte("ROLLUP"),
+		// 		exp.CubeGroupingSetType:         []byte("CUBE"),
+		// 		exp.GroupingSetsGroupingSetType: []byte("GROUPING SETS"),
+		// 	})
+		GroupingSetTypeLookup map[exp.GroupingSetType][]byte
+		// A map used to look up JSONOperations and their SQL equivalents. If UseJSONFunctions is true the values are
+		// function names instead of operators. Remove an operation from the map if the dialect does not support it.
+		// (Default= map[exp.JSONOperation][]byte{
+		// 		exp.JSONGetOp:      []byte("->"),
+		// 		exp.JSONGetTextOp:  []byte("->>"),
+		// 		exp.JSONPathOp:     []byte("#>"),
+		// 		exp.JSONPathTextOp: []byte("#>>"),
+		// 		exp.JSONContainsOp: []byte("@>"),
+		// 		exp.JSONHasKeyOp:   []byte("?"),
+		// 	})
+		JSONOperatorLookup map[exp.JSONOperation][]byte
+		// A map used to look up ArrayOperations and their SQL equivalents
+		// (Default= map[exp.ArrayOperation][]byte{
+		// 		exp.ArrayContainsOp:    []byte("@>"),
+		// 		exp.ArrayContainedByOp: []byte("<@"),
+		// 		exp.ArrayOverlapsOp:    []byte("&&"),
+		// 		exp.ArrayConcatOp:      []byte("||"),
+		// 	})
+		ArrayOperatorLookup map[exp.ArrayOperation][]byte
 		// Whether or not boolean data type is supported
 		BooleanDataTypeSupported bool
 		// Whether or not to use literal TRUE or FALSE for IS statements (e.g. IS TRUE or IS 0)
@@ -266,6 +420,29 @@ type (
 		ConflictDoNothingFragment []byte
 		// The SQL fragment to use for CONFLICT DO UPDATE (Default=[]byte(" DO UPDATE SET"))
 		ConflictDoUpdateFragment []byte
+		// The SQL fragment to use for an ON CONSTRAINT conflict target (Default=[]byte(" ON CONSTRAINT "))
+		OnConstraintFragment []byte
+		// The SQL fragment to write before a reference to a column of the row proposed for insertion
+		// (Default=[]byte("EXCLUDED."))
+		ExcludedPrefixFragment []byte
+		// The SQL fragment to write after a reference to a column of the row proposed for insertion
+		// (Default=[]byte(""))
+		ExcludedSuffixFragment []byte
+		// The function used to convert extracted JSON to text when UseJSONFunctions is true, if empty the extracted
+		// value is used as is (Default=[]byte(""))
+		JSONUnquoteFragment []byte
+		// The SQL fragment to write before the elements of an array (Default=[]byte("ARRAY["))
+		ArrayStartFragment []byte
+		// The SQL fragment to write after the elements of an array (Default=[]byte("]"))
+		ArrayEndFragment []byte
+		// The SQL fragment used to create a savepoint, if empty the dialect does not support savepoints
+		// (Default=[]byte("SAVEPOINT "))
+		SavepointFragment []byte
+		// The SQL fragment used to release a savepoint, if empty releasing a savepoint is a no-op
+		// (Default=[]byte("RELEASE SAVEPOINT "))
+		ReleaseSavepointFragment []byte
+		// The SQL fragment used to roll back to a savepoint (Default=[]byte("ROLLBACK TO SAVEPOINT "))
+		RollbackToSavepointFragment []byte
 
 		// The order of SQL fragments when creating a SELECT statement
 		// (Default=[]SQLFragmentType{
@@ -324,6 +501,16 @@ type (
 		// 		TruncateSQLFragment,
 		// 	})
 		TruncateSQLOrder []SQLFragmentType
+
+		// The order of SQL fragments when creating a MERGE statement
+		// (Default=[]SQLFragmentType{
+		// 		CommonTableSQLFragment,
+		// 		MergeBeginSQLFragment,
+		// 		MergeUsingSQLFragment,
+		// 		MergeOnSQLFragment,
+		// 		MergeWhenSQLFragment,
+		// 	})
+		MergeSQLOrder []SQLFragmentType
 	}
 )
 
@@ -353,6 +540,10 @@ const (
 	DeleteBeginSQLFragment
 	TruncateSQLFragment
 	WindowSQLFragment
+	MergeBeginSQLFragment
+	MergeUsingSQLFragment
+	MergeOnSQLFragment
+	MergeWhenSQLFragment
 )
 
 //nolint:gocyclo // simple type to string conversion
@@ -402,6 +593,14 @@ func (sf SQLFragmentType) String() string {
 		return "TruncateSQLFragment"
 	case WindowSQLFragment:
 		return "WindowSQLFragment"
+	case MergeBeginSQLFragment:
+		return "MergeBeginSQLFragment"
+	case MergeUsingSQLFragment:
+		return "MergeUsingSQLFragment"
+	case MergeOnSQLFragment:
+		return "MergeOnSQLFragment"
+	case MergeWhenSQLFragment:
+		return "MergeWhenSQLFragment"
 	}
 	return fmt.Sprintf("%d", sf)
 }
@@ -418,77 +617,112 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		SupportsConflictUpdateWhere: true,
 		SupportsInsertIgnoreSyntax:  false,
 		SupportsConflictTarget:      true,
+		SupportsConflictConstraint:  true,
+		SupportsExcludedRow:         true,
 		SupportsWithCTE:             true,
 		SupportsWithCTERecursive:    true,
 		SupportsDistinctOn:          true,
 		WrapCompoundsInParens:       true,
 		SupportsWindowFunction:      true,
+		SupportsWindowFrame:         true,
 		SupportsLateral:             true,
+		SupportsExcept:              true,
+		SupportsExceptAll:           true,
+		SupportsFilterClause:        true,
+		SupportsWithinGroup:         true,
+		SupportsMerge:               true,
+		SupportsMergeDoNothing:      true,
+		SupportsArrays:              true,
 
 		SupportsMultipleUpdateTables:         true,
+		SupportsWindowFrameExclusion:         true,
+		SupportsRowValueComparison:           true,
 		UseFromClauseForMultipleUpdateTables: true,
 
-		UpdateClause:              []byte("UPDATE"),
-		InsertClause:              []byte("INSERT INTO"),
-		InsertIgnoreClause:        []byte("INSERT IGNORE INTO"),
-		SelectClause:              []byte("SELECT"),
-		DeleteClause:              []byte("DELETE"),
-		TruncateClause:            []byte("TRUNCATE"),
-		WithFragment:              []byte("WITH "),
-		RecursiveFragment:         []byte("RECURSIVE "),
-		CascadeFragment:           []byte(" CASCADE"),
-		RestrictFragment:          []byte(" RESTRICT"),
-		DefaultValuesFragment:     []byte(" DEFAULT VALUES"),
-		ValuesFragment:            []byte(" VALUES "),
-		IdentityFragment:          []byte(" IDENTITY"),
-		SetFragment:               []byte(" SET "),
-		DistinctFragment:          []byte("DISTINCT"),
-		ReturningFragment:         []byte(" RETURNING "),
-		FromFragment:              []byte(" FROM"),
-		UsingFragment:             []byte(" USING "),
-		OnFragment:                []byte(" ON "),
-		WhereFragment:             []byte(" WHERE "),
-		GroupByFragment:           []byte(" GROUP BY "),
-		HavingFragment:            []byte(" HAVING "),
-		WindowFragment:            []byte(" WINDOW "),
-		WindowPartitionByFragment: []byte("PARTITION BY "),
-		WindowOrderByFragment:     []byte("ORDER BY "),
-		WindowOverFragment:        []byte(" OVER "),
-		OrderByFragment:           []byte(" ORDER BY "),
-		FetchFragment:             []byte(" "),
-		LimitFragment:             []byte(" LIMIT "),
-		OffsetFragment:            []byte(" OFFSET "),
-		ForUpdateFragment:         []byte(" FOR UPDATE "),
-		ForNoKeyUpdateFragment:    []byte(" FOR NO KEY UPDATE "),
-		ForShareFragment:          []byte(" FOR SHARE "),
-		ForKeyShareFragment:       []byte(" FOR KEY SHARE "),
-		OfFragment:                []byte("OF "),
-		NowaitFragment:            []byte("NOWAIT"),
-		SkipLockedFragment:        []byte("SKIP LOCKED"),
-		LateralFragment:           []byte("LATERAL "),
-		AsFragment:                []byte(" AS "),
-		AscFragment:               []byte(" ASC"),
-		DescFragment:              []byte(" DESC"),
-		NullsFirstFragment:        []byte(" NULLS FIRST"),
-		NullsLastFragment:         []byte(" NULLS LAST"),
-		AndFragment:               []byte(" AND "),
-		OrFragment:                []byte(" OR "),
-		UnionFragment:             []byte(" UNION "),
-		UnionAllFragment:          []byte(" UNION ALL "),
-		IntersectFragment:         []byte(" INTERSECT "),
-		IntersectAllFragment:      []byte(" INTERSECT ALL "),
-		ConflictFragment:          []byte(" ON CONFLICT"),
-		ConflictDoUpdateFragment:  []byte(" DO UPDATE SET "),
-		ConflictDoNothingFragment: []byte(" DO NOTHING"),
-		CastFragment:              []byte("CAST"),
-		CaseFragment:              []byte("CASE "),
-		WhenFragment:              []byte(" WHEN "),
-		ThenFragment:              []byte(" THEN "),
-		ElseFragment:              []byte(" ELSE "),
-		EndFragment:               []byte(" END"),
-		Null:                      []byte("NULL"),
-		True:                      []byte("TRUE"),
-		False:                     []byte("FALSE"),
+		UpdateClause:                     []byte("UPDATE"),
+		InsertClause:                     []byte("INSERT INTO"),
+		InsertIgnoreClause:               []byte("INSERT IGNORE INTO"),
+		SelectClause:                     []byte("SELECT"),
+		DeleteClause:                     []byte("DELETE"),
+		TruncateClause:                   []byte("TRUNCATE"),
+		MergeClause:                      []byte("MERGE INTO"),
+		WithFragment:                     []byte("WITH "),
+		RecursiveFragment:                []byte("RECURSIVE "),
+		CascadeFragment:                  []byte(" CASCADE"),
+		RestrictFragment:                 []byte(" RESTRICT"),
+		DefaultValuesFragment:            []byte(" DEFAULT VALUES"),
+		ValuesFragment:                   []byte(" VALUES "),
+		IdentityFragment:                 []byte(" IDENTITY"),
+		SetFragment:                      []byte(" SET "),
+		DistinctFragment:                 []byte("DISTINCT"),
+		ReturningFragment:                []byte(" RETURNING "),
+		FromFragment:                     []byte(" FROM"),
+		UsingFragment:                    []byte(" USING "),
+		OnFragment:                       []byte(" ON "),
+		WhereFragment:                    []byte(" WHERE "),
+		GroupByFragment:                  []byte(" GROUP BY "),
+		HavingFragment:                   []byte(" HAVING "),
+		WindowFragment:                   []byte(" WINDOW "),
+		WindowPartitionByFragment:        []byte("PARTITION BY "),
+		WindowOrderByFragment:            []byte("ORDER BY "),
+		WindowOverFragment:               []byte(" OVER "),
+		WindowBetweenFragment:            []byte(" BETWEEN "),
+		FilterFragment:                   []byte(" FILTER (WHERE "),
+		WithinGroupFragment:              []byte(" WITHIN GROUP (ORDER BY "),
+		WithRollupFragment:               []byte(" WITH ROLLUP"),
+		MergeMatchedFragment:             []byte(" WHEN MATCHED"),
+		MergeNotMatchedFragment:          []byte(" WHEN NOT MATCHED"),
+		MergeInsertFragment:              []byte("INSERT"),
+		MergeInsertDefaultValuesFragment: []byte(" DEFAULT VALUES"),
+		MergeDoNothingFragment:           []byte("DO NOTHING"),
+		MergeEndFragment:                 []byte(""),
+		OrderByFragment:                  []byte(" ORDER BY "),
+		FetchFragment:                    []byte(" "),
+		LimitFragment:                    []byte(" LIMIT "),
+		OffsetFragment:                   []byte(" OFFSET "),
+		ForUpdateFragment:                []byte(" FOR UPDATE "),
+		ForNoKeyUpdateFragment:           []byte(" FOR NO KEY UPDATE "),
+		ForShareFragment:                 []byte(" FOR SHARE "),
+		ForKeyShareFragment:              []byte(" FOR KEY SHARE "),
+		OfFragment:                       []byte("OF "),
+		NowaitFragment:                   []byte("NOWAIT"),
+		SkipLockedFragment:               []byte("SKIP LOCKED"),
+		LateralFragment:                  []byte("LATERAL "),
+		AsFragment:                       []byte(" AS "),
+		AscFragment:                      []byte(" ASC"),
+		DescFragment:                     []byte(" DESC"),
+		NullsFirstFragment:               []byte(" NULLS FIRST"),
+		NullsLastFragment:                []byte(" NULLS LAST"),
+		AndFragment:                      []byte(" AND "),
+		OrFragment:                       []byte(" OR "),
+		UnionFragment:                    []byte(" UNION "),
+		UnionAllFragment:                 []byte(" UNION ALL "),
+		IntersectFragment:                []byte(" INTERSECT "),
+		IntersectAllFragment:             []byte(" INTERSECT ALL "),
+		ExceptFragment:                   []byte(" EXCEPT "),
+		ExceptAllFragment:                []byte(" EXCEPT ALL "),
+		ConflictFragment:                 []byte(" ON CONFLICT"),
+		ConflictDoUpdateFragment:         []byte(" DO UPDATE SET "),
+		ConflictDoNothingFragment:        []byte(" DO NOTHING"),
+		OnConstraintFragment:             []byte(" ON CONSTRAINT "),
+		ExcludedPrefixFragment:           []byte("EXCLUDED."),
+		ExcludedSuffixFragment:           []byte(""),
+		JSONUnquoteFragment:              []byte(""),
+		ArrayStartFragment:               []byte("ARRAY["),
+		ArrayEndFragment:                 []byte("]"),
+		CastFragment:                     []byte("CAST"),
+		CaseFragment:                     []byte("CASE "),
+		WhenFragment:                     []byte(" WHEN "),
+		ThenFragment:                     []byte(" THEN "),
+		ElseFragment:                     []byte(" ELSE "),
+		EndFragment:                      []byte(" END"),
+		Null:                             []byte("NULL"),
+		True:                             []byte("TRUE"),
+		False:                            []byte("FALSE"),
+
+		SavepointFragment:           []byte("SAVEPOINT "),
+		ReleaseSavepointFragment:    []byte("RELEASE SAVEPOINT "),
+		RollbackToSavepointFragment: []byte("ROLLBACK TO SAVEPOINT "),
 
 		PlaceHolderFragment: []byte("?"),
 		QuoteRune:           '"',
@@ -548,6 +782,43 @@ func DefaultDialectOptions() *SQLDialectOptions {
 			exp.NaturalFullJoinType:  []byte(" NATURAL FULL JOIN "),
 			exp.CrossJoinType:        []byte(" CROSS JOIN "),
 		},
+		WindowFrameUnitLookup: map[exp.WindowFrameUnit][]byte{
+			exp.RowsFrameUnit:   []byte("ROWS"),
+			exp.RangeFrameUnit:  []byte("RANGE"),
+			exp.GroupsFrameUnit: []byte("GROUPS"),
+		},
+		WindowFrameBoundLookup: map[exp.WindowFrameBoundType][]byte{
+			exp.UnboundedPrecedingFrameBound: []byte("UNBOUNDED PRECEDING"),
+			exp.PrecedingFrameBound:          []byte(" PRECEDING"),
+			exp.CurrentRowFrameBound:         []byte("CURRENT ROW"),
+			exp.FollowingFrameBound:          []byte(" FOLLOWING"),
+			exp.UnboundedFollowingFrameBound: []byte("UNBOUNDED FOLLOWING"),
+		},
+		WindowFrameExclusionLookup: map[exp.WindowFrameExclusion][]byte{
+			exp.ExcludeCurrentRowFrameExclusion: []byte(" EXCLUDE CURRENT ROW"),
+			exp.ExcludeGroupFrameExclusion:      []byte(" EXCLUDE GROUP"),
+			exp.ExcludeTiesFrameExclusion:       []byte(" EXCLUDE TIES"),
+			exp.ExcludeNoOthersFrameExclusion:   []byte(" EXCLUDE NO OTHERS"),
+		},
+		GroupingSetTypeLookup: map[exp.GroupingSetType][]byte{
+			exp.RollupGroupingSetType:       []byte("ROLLUP"),
+			exp.CubeGroupingSetType:         []byte("CUBE"),
+			exp.GroupingSetsGroupingSetType: []byte("GROUPING SETS"),
+		},
+		JSONOperatorLookup: map[exp.JSONOperation][]byte{
+			exp.JSONGetOp:      []byte("->"),
+			exp.JSONGetTextOp:  []byte("->>"),
+			exp.JSONPathOp:     []byte("#>"),
+			exp.JSONPathTextOp: []byte("#>>"),
+			exp.JSONContainsOp: []byte("@>"),
+			exp.JSONHasKeyOp:   []byte("?"),
+		},
+		ArrayOperatorLookup: map[exp.ArrayOperation][]byte{
+			exp.ArrayContainsOp:    []byte("@>"),
+			exp.ArrayContainedByOp: []byte("<@"),
+			exp.ArrayOverlapsOp:    []byte("&&"),
+			exp.ArrayConcatOp:      []byte("||"),
+		},
 
 		TimeFormat: time.RFC3339Nano,
 
@@ -603,5 +874,12 @@ func DefaultDialectOptions() *SQLDialectOptions {
 		TruncateSQLOrder: []SQLFragmentType{
 			TruncateSQLFragment,
 		},
+		MergeSQLOrder: []SQLFragmentType{
+			CommonTableSQLFragment,
+			MergeBeginSQLFragment,
+			MergeUsingSQLFragment,
+			MergeOnSQLFragment,
+			MergeWhenSQLFragment,
+		},
 	}
 }
@@ -38,6 +38,10 @@ func (sfts *sqlFragmentTypeSuite) TestOptions_SQLFragmentType() {
 		{typ: sqlgen.DeleteBeginSQLFragment, expectedStr: "DeleteBeginSQLFragment"},
 		{typ: sqlgen.TruncateSQLFragment, expectedStr: "TruncateSQLFragment"},
 		{typ: sqlgen.WindowSQLFragment, expectedStr: "WindowSQLFragment"},
+		{typ: sqlgen.MergeBeginSQLFragment, expectedStr: "MergeBeginSQLFragment"},
+		{typ: sqlgen.MergeUsingSQLFragment, expectedStr: "MergeUsingSQLFragment"},
+		{typ: sqlgen.MergeOnSQLFragment, expectedStr: "MergeOnSQLFragment"},
+		{typ: sqlgen.MergeWhenSQLFragment, expectedStr: "MergeWhenSQLFragment"},
 		{typ: sqlgen.SQLFragmentType(10000), expectedStr: "10000"},
 	} {
 		sfts.Equal(tt.expectedStr, tt.typ.String())
@@ -40,7 +40,7 @@ func (usg *updateSQLGenerator) Generate(b sb.SQLBuilder, clauses exp.UpdateClaus
 		return
 	}
 	if !usg.DialectOptions().SupportsMultipleUpdateTables && clauses.HasFrom() {
-		b.SetError(errors.New("%s dialect does not support multiple tables in UPDATE", usg.Dialect()))
+		b.SetError(errors.NewUnsupportedFeatureError("%s dialect does not support multiple tables in UPDATE", usg.Dialect()))
 	}
 	updates, err := exp.NewUpdateExpressions(clauses.SetValues())
 	if err != nil {
@@ -0,0 +1,207 @@
+// Package tracing provides a goqu.QueryHook that creates a span for every statement executed by a goqu.Database or
+// goqu.TxDatabase.
+//
+// The Tracer and Span interfaces are the subset of the OpenTelemetry tracing API used by this package, so an
+// OpenTelemetry tracer can be used with a small adapter without this module depending on OpenTelemetry. The span
+// attributes follow the OpenTelemetry database semantic conventions.
+//
+//	db := goqu.New("postgres", pgDb)
+//	db.AddQueryHook(tracing.NewQueryHook(myTracer, db.Dialect()))
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exec"
+)
+
+// Attribute keys set on each span.
+const (
+	// The database system the statement was executed against (e.g. postgresql, mysql).
+	DBSystemKey = "db.system"
+	// The statement with all literal values replaced with a ?.
+	DBStatementKey = "db.statement"
+	// The operation of the dataset that executed the statement (e.g. SELECT, INSERT).
+	DBOperationKey = "db.operation"
+	// The number of rows affected by an EXEC.
+	DBRowsAffectedKey = "db.rows_affected"
+)
+
+type (
+	// A key value pair set on a span.
+	Attribute struct {
+		Key   string
+		Value interface{}
+	}
+	// Starts spans, see go.opentelemetry.io/otel/trace#Tracer.
+	Tracer interface {
+		Start(ctx context.Context, spanName string) (context.Context, Span)
+	}
+	// A single statement being executed, see go.opentelemetry.io/otel/trace#Span.
+	Span interface {
+		SetAttributes(attrs ...Attribute)
+		RecordError(err error)
+		End()
+	}
+	queryHook struct {
+		tracer    Tracer
+		system    string
+		sanitizer sanitizer
+	}
+	spanCtxKey struct {
+		hook *queryHook
+	}
+)
+
+// The OpenTelemetry db.system values for the dialects shipped with goqu. Any other dialect name is used as is.
+var dbSystems = map[string]string{
+	"default":   "other_sql",
+	"mysql8":    "mysql",
+	"postgres":  "postgresql",
+	"sqlite3":   "sqlite",
+	"sqlserver": "mssql",
+}
+
+// Creates a goqu.QueryHook that starts a span using the tracer for every statement executed.
+//
+// tracer: The tracer used to start the spans.
+//
+// dialect: The name of the dialect the statements are generated with (e.g. Database#Dialect). The dialect is used to
+// set db.system and to find the literals that are removed from db.statement.
+func NewQueryHook(tracer Tracer, dialect string) goqu.QueryHook {
+	system, ok := dbSystems[dialect]
+	if !ok {
+		system = dialect
+	}
+	return &queryHook{
+		tracer:    tracer,
+		system:    system,
+		sanitizer: newSanitizer(goqu.GetDialect(dialect)),
+	}
+}
+
+func (qh *queryHook) BeforeQuery(ctx context.Context, op, query string, args []interface{}) context.Context {
+	operation := exec.OperationFromContext(ctx)
+	spanName := operation
+	if spanName == "" {
+		spanName = op
+	}
+	ctx, span := qh.tracer.Start(ctx, spanName)
+	attrs := []Attribute{
+		{Key: DBSystemKey, Value: qh.system},
+		{Key: DBStatementKey, Value: qh.sanitizer.sanitize(query)},
+	}
+	if operation != "" {
+		attrs = append(attrs, Attribute{Key: DBOperationKey, Value: operation})
+	}
+	span.SetAttributes(attrs...)
+	return context.WithValue(ctx, spanCtxKey{hook: qh}, span)
+}
+
+func (qh *queryHook) AfterQuery(ctx context.Context, op, query string, args []interface{}, err error, duration time.Duration) {
+	qh.AfterQueryResult(ctx, op, query, args, nil, err, duration)
+}
+
+func (qh *queryHook) AfterQueryResult(
+	ctx context.Context,
+	op, query string,
+	args []interface{},
+	result sql.Result,
+	err error,
+	duration time.Duration,
+) {
+	span, ok := ctx.Value(spanCtxKey{hook: qh}).(Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+	if err != nil {
+		span.RecordError(err)
+		return
+	}
+	if result != nil {
+		if rowsAffected, raErr := result.RowsAffected(); raErr == nil {
+			span.SetAttributes(Attribute{Key: DBRowsAffectedKey, Value: rowsAffected})
+		}
+	}
+}
+
+// used internally to remove literal values from statements so they are not recorded on spans.
+type sanitizer struct {
+	quoteRune        rune
+	stringQuote      rune
+	backslashEscapes bool
+}
+
+func newSanitizer(dialect goqu.SQLDialect) sanitizer {
+	opts := dialect.DialectOptions()
+	_, backslashEscapes := opts.EscapedRunes['\\']
+	return sanitizer{
+		quoteRune:        opts.QuoteRune,
+		stringQuote:      opts.StringQuote,
+		backslashEscapes: backslashEscapes,
+	}
+}
+
+// Replaces every string and numeric literal in the query with a ?. Quoted identifiers and placeholders (e.g. $1) are
+// left as is. An unterminated string is treated as running to the end of the query so its contents are never kept.
+func (s sanitizer) sanitize(query string) string {
+	runes := []rune(query)
+	var buf strings.Builder
+	buf.Grow(len(query))
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == s.quoteRune:
+			end := s.endOfQuoted(runes, i, r, false)
+			buf.WriteString(string(runes[i:end]))
+			i = end
+		case r == s.stringQuote:
+			buf.WriteRune('?')
+			i = s.endOfQuoted(runes, i, r, s.backslashEscapes)
+		case unicode.IsDigit(r) && (i == 0 || !isIdentifierRune(runes[i-1])):
+			buf.WriteRune('?')
+			i = endOfNumber(runes, i)
+		default:
+			buf.WriteRune(r)
+			i++
+		}
+	}
+	return buf.String()
+}
+
+// returns the index after the closing quote of the quoted text starting at start, a doubled quote is an escaped quote.
+func (s sanitizer) endOfQuoted(runes []rune, start int, quote rune, backslashEscapes bool) int {
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if backslashEscapes {
+				i++
+			}
+		case quote:
+			if i+1 < len(runes) && runes[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(runes)
+}
+
+func endOfNumber(runes []rune, start int) int {
+	i := start
+	for i < len(runes) && (isIdentifierRune(runes[i]) || runes[i] == '.') {
+		i++
+	}
+	return i
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || r == '$' || r == '@' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
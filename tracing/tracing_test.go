@@ -0,0 +1,213 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/doug-martin/goqu/v9/tracing"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	recordedSpan struct {
+		Name       string
+		Parent     string
+		Attributes map[string]interface{}
+		Errors     []string
+		Ended      bool
+	}
+	spanRecorder struct {
+		spans []*recordedSpan
+	}
+	recordedSpanCtxKey struct{}
+	tracingSuite       struct {
+		suite.Suite
+	}
+)
+
+func (sr *spanRecorder) Start(ctx context.Context, spanName string) (context.Context, tracing.Span) {
+	span := &recordedSpan{Name: spanName, Attributes: map[string]interface{}{}}
+	if parent, ok := ctx.Value(recordedSpanCtxKey{}).(*recordedSpan); ok {
+		span.Parent = parent.Name
+	}
+	sr.spans = append(sr.spans, span)
+	return context.WithValue(ctx, recordedSpanCtxKey{}, span), span
+}
+
+func (rs *recordedSpan) SetAttributes(attrs ...tracing.Attribute) {
+	for _, attr := range attrs {
+		rs.Attributes[attr.Key] = attr.Value
+	}
+}
+
+func (rs *recordedSpan) RecordError(err error) {
+	rs.Errors = append(rs.Errors, err.Error())
+}
+
+func (rs *recordedSpan) End() {
+	rs.Ended = true
+}
+
+func (ts *tracingSuite) TestNewQueryHook_Datasets() {
+	mDB, mock, err := sqlmock.New()
+	ts.NoError(err)
+	mock.ExpectQuery(`SELECT "name" FROM "items" WHERE \("name" = 'Bob'\)`).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).FromCSVString("Bob"))
+	mock.ExpectExec(`UPDATE "items" SET "age"=10 WHERE \("id" = 1\)`).
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO "items" \("age", "name"\) VALUES \(\$1, \$2\)`).
+		WithArgs(10, "Bob").
+		WillReturnError(errors.New("insert error"))
+
+	recorder := new(spanRecorder)
+	db := goqu.New("postgres", mDB)
+	db.AddQueryHook(tracing.NewQueryHook(recorder, db.Dialect()))
+
+	var names []string
+	ts.NoError(db.From("items").Select("name").Where(goqu.C("name").Eq("Bob")).ScanVals(&names))
+	_, err = db.Update("items").Set(goqu.Record{"age": 10}).Where(goqu.C("id").Eq(1)).Executor().Exec()
+	ts.NoError(err)
+	_, err = db.Insert("items").Prepared(true).Rows(goqu.Record{"name": "Bob", "age": 10}).Executor().Exec()
+	ts.EqualError(err, "goqu: insert error")
+
+	ts.Equal([]*recordedSpan{
+		{
+			Name: "SELECT",
+			Attributes: map[string]interface{}{
+				tracing.DBSystemKey:    "postgresql",
+				tracing.DBStatementKey: `SELECT "name" FROM "items" WHERE ("name" = ?)`,
+				tracing.DBOperationKey: "SELECT",
+			},
+			Ended: true,
+		},
+		{
+			Name: "UPDATE",
+			Attributes: map[string]interface{}{
+				tracing.DBSystemKey:       "postgresql",
+				tracing.DBStatementKey:    `UPDATE "items" SET "age"=? WHERE ("id" = ?)`,
+				tracing.DBOperationKey:    "UPDATE",
+				tracing.DBRowsAffectedKey: int64(2),
+			},
+			Ended: true,
+		},
+		{
+			Name: "INSERT",
+			Attributes: map[string]interface{}{
+				tracing.DBSystemKey:    "postgresql",
+				tracing.DBStatementKey: `INSERT INTO "items" ("age", "name") VALUES ($1, $2)`,
+				tracing.DBOperationKey: "INSERT",
+			},
+			Errors: []string{"goqu: insert error"},
+			Ended:  true,
+		},
+	}, recorder.spans)
+	ts.NoError(mock.ExpectationsWereMet())
+}
+
+func (ts *tracingSuite) TestNewQueryHook_RawSQL() {
+	mDB, mock, err := sqlmock.New()
+	ts.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "items" WHERE "name" = 'it''s' AND "id" > 10`).
+		WithArgs().
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	recorder := new(spanRecorder)
+	db := goqu.New("postgres", mDB)
+	db.AddQueryHook(tracing.NewQueryHook(recorder, db.Dialect()))
+
+	ctx, _ := recorder.Start(context.Background(), "parent")
+	ts.NoError(db.WithTx(func(tx *goqu.TxDatabase) error {
+		_, execErr := tx.ExecContext(ctx, `DELETE FROM "items" WHERE "name" = 'it''s' AND "id" > 10`)
+		return execErr
+	}))
+
+	ts.Equal([]*recordedSpan{
+		{Name: "parent", Attributes: map[string]interface{}{}},
+		{
+			Name:   "EXEC",
+			Parent: "parent",
+			Attributes: map[string]interface{}{
+				tracing.DBSystemKey:       "postgresql",
+				tracing.DBStatementKey:    `DELETE FROM "items" WHERE "name" = ? AND "id" > ?`,
+				tracing.DBRowsAffectedKey: int64(1),
+			},
+			Ended: true,
+		},
+	}, recorder.spans)
+	ts.NoError(mock.ExpectationsWereMet())
+}
+
+func (ts *tracingSuite) TestNewQueryHook_Sanitize() {
+	cases := []struct {
+		dialect  string
+		system   string
+		query    string
+		expected string
+	}{
+		{
+			dialect:  "postgres",
+			system:   "postgresql",
+			query:    `SELECT "t1"."col2" FROM "table2" AS "t1" WHERE ("price" >= 10.5) LIMIT 5`,
+			expected: `SELECT "t1"."col2" FROM "table2" AS "t1" WHERE ("price" >= ?) LIMIT ?`,
+		},
+		{
+			dialect:  "postgres",
+			system:   "postgresql",
+			query:    `SELECT * FROM "col""1" WHERE "a" IN ('a', 'b\', 'c')`,
+			expected: `SELECT * FROM "col""1" WHERE "a" IN (?, ?, ?)`,
+		},
+		{
+			dialect:  "postgres",
+			system:   "postgresql",
+			query:    `SELECT * FROM "items" WHERE "name" = 'unterminated`,
+			expected: `SELECT * FROM "items" WHERE "name" = ?`,
+		},
+		{
+			dialect:  "mysql",
+			system:   "mysql",
+			query:    "SELECT `col1` FROM `table1` WHERE `name` = 'it\\'s' AND `v2` = 2",
+			expected: "SELECT `col1` FROM `table1` WHERE `name` = ? AND `v2` = ?",
+		},
+		{
+			dialect:  "mysql",
+			system:   "mysql",
+			query:    "SELECT * FROM `items` WHERE `name` = ? AND `age` = ?",
+			expected: "SELECT * FROM `items` WHERE `name` = ? AND `age` = ?",
+		},
+		{
+			dialect:  "mysql8",
+			system:   "mysql",
+			query:    "SELECT * FROM `items` WHERE `name` = 'a'",
+			expected: "SELECT * FROM `items` WHERE `name` = ?",
+		},
+		{
+			dialect:  "unknown",
+			system:   "unknown",
+			query:    `SELECT * FROM "items" WHERE "id" = 1`,
+			expected: `SELECT * FROM "items" WHERE "id" = ?`,
+		},
+	}
+	for _, c := range cases {
+		recorder := new(spanRecorder)
+		hook := tracing.NewQueryHook(recorder, c.dialect)
+		ctx := hook.BeforeQuery(context.Background(), "QUERY", c.query, nil)
+		hook.AfterQuery(ctx, "QUERY", c.query, nil, nil, 0)
+		ts.Require().Len(recorder.spans, 1)
+		ts.Equal(c.system, recorder.spans[0].Attributes[tracing.DBSystemKey], c.query)
+		ts.Equal(c.expected, recorder.spans[0].Attributes[tracing.DBStatementKey], c.query)
+		ts.True(recorder.spans[0].Ended)
+	}
+}
+
+func TestTracingSuite(t *testing.T) {
+	suite.Run(t, new(tracingSuite))
+}
@@ -158,7 +158,7 @@ func (td *TruncateDataset) ToSQL() (sql string, params []interface{}, err error)
 //
 //	db.From("test").Truncate().Executor().Exec()
 func (td *TruncateDataset) Executor() exec.QueryExecutor {
-	return td.queryFactory.FromSQLBuilder(td.truncateSQLBuilder())
+	return td.queryFactory.FromSQLBuilder(td.truncateSQLBuilder()).WithOperation(exec.OperationTruncate)
 }
 
 func (td *TruncateDataset) truncateSQLBuilder() sb.SQLBuilder {
@@ -0,0 +1,108 @@
+package goqu
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Options used by Database#WithTxRetry. Any zero value is replaced with its default.
+type TxRetryOptions struct {
+	// The maximum number of times the transaction is executed (DEFAULT=3)
+	MaxAttempts int
+	// The delay before the first retry (DEFAULT=10ms)
+	InitialBackoff time.Duration
+	// The maximum delay between retries (DEFAULT=1s)
+	MaxBackoff time.Duration
+	// The amount the delay is multiplied by after each retry (DEFAULT=2)
+	Multiplier float64
+	// Used to compute the delay before a retry instead of the exponential backoff. attempt is the number of the
+	// attempt that failed starting at 1 (DEFAULT=nil)
+	Backoff func(attempt int) time.Duration
+	// Used to determine if an error is retryable instead of the classifier registered by the dialect, see
+	// SQLDialectOptions.IsRetryableError (DEFAULT=nil)
+	IsRetryable func(err error) bool
+	// The options used to begin each transaction (DEFAULT=nil)
+	TxOptions *sql.TxOptions
+}
+
+const (
+	defaultTxRetryMaxAttempts    = 3
+	defaultTxRetryInitialBackoff = 10 * time.Millisecond
+	defaultTxRetryMaxBackoff     = time.Second
+	defaultTxRetryMultiplier     = 2
+)
+
+// Executes fn inside of a transaction, if the transaction fails with a retryable error (e.g. a serialization failure
+// or deadlock) the transaction is rolled back and fn is executed again in a new transaction. Retryable errors are
+// determined by the classifier registered by the dialect (e.g. postgres 40001 and 40P01), see
+// SQLDialectOptions.IsRetryableError.
+//
+// fn may be executed more than once so it should not have side effects outside of the transaction. The error from the
+// last attempt is returned once TxRetryOptions.MaxAttempts is reached, or ctx.Err() if the context is done while
+// waiting to retry.
+//
+//	err := db.WithTxRetry(ctx, goqu.TxRetryOptions{MaxAttempts: 5}, func(tx *goqu.TxDatabase) error {
+//	    _, err := tx.Update("accounts").Set(goqu.Record{"balance": balance}).Where(goqu.C("id").Eq(id)).Executor().Exec()
+//	    return err
+//	})
+func (d *Database) WithTxRetry(ctx context.Context, opts TxRetryOptions, fn func(tx *TxDatabase) error) error {
+	opts = opts.withDefaults()
+	isRetryable := opts.IsRetryable
+	if isRetryable == nil {
+		isRetryable = GetDialect(d.dialect).DialectOptions().IsRetryableError
+	}
+	for attempt := 1; ; attempt++ {
+		err := d.withTxOptions(ctx, opts.TxOptions, fn)
+		if err == nil || attempt >= opts.MaxAttempts || isRetryable == nil || !isRetryable(err) {
+			return err
+		}
+		timer := time.NewTimer(opts.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// used internally to execute a single attempt of WithTxRetry
+func (d *Database) withTxOptions(ctx context.Context, txOpts *sql.TxOptions, fn func(tx *TxDatabase) error) error {
+	tx, err := d.BeginTx(ctx, txOpts)
+	if err != nil {
+		return err
+	}
+	return tx.Wrap(func() error { return fn(tx) })
+}
+
+func (o TxRetryOptions) withDefaults() TxRetryOptions {
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = defaultTxRetryMaxAttempts
+	}
+	if o.InitialBackoff <= 0 {
+		o.InitialBackoff = defaultTxRetryInitialBackoff
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = defaultTxRetryMaxBackoff
+	}
+	if o.Multiplier <= 0 {
+		o.Multiplier = defaultTxRetryMultiplier
+	}
+	return o
+}
+
+// returns the delay before retrying the failed attempt
+func (o TxRetryOptions) backoff(attempt int) time.Duration {
+	if o.Backoff != nil {
+		return o.Backoff(attempt)
+	}
+	backoff := float64(o.InitialBackoff)
+	for i := 1; i < attempt && backoff < float64(o.MaxBackoff); i++ {
+		backoff *= o.Multiplier
+	}
+	if backoff > float64(o.MaxBackoff) {
+		return o.MaxBackoff
+	}
+	return time.Duration(backoff)
+}
@@ -0,0 +1,41 @@
+package goqu
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type txRetryOptionsSuite struct {
+	suite.Suite
+}
+
+func (tros *txRetryOptionsSuite) TestWithDefaults() {
+	tros.Equal(TxRetryOptions{
+		MaxAttempts:    3,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}, TxRetryOptions{}.withDefaults())
+
+	opts := TxRetryOptions{MaxAttempts: 5, InitialBackoff: time.Millisecond, MaxBackoff: time.Minute, Multiplier: 3}
+	tros.Equal(opts, opts.withDefaults())
+}
+
+func (tros *txRetryOptionsSuite) TestBackoff() {
+	opts := TxRetryOptions{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 100 * time.Millisecond}.withDefaults()
+	tros.Equal(10*time.Millisecond, opts.backoff(1))
+	tros.Equal(20*time.Millisecond, opts.backoff(2))
+	tros.Equal(40*time.Millisecond, opts.backoff(3))
+	tros.Equal(80*time.Millisecond, opts.backoff(4))
+	tros.Equal(100*time.Millisecond, opts.backoff(5))
+	tros.Equal(100*time.Millisecond, opts.backoff(100))
+
+	opts.Backoff = func(attempt int) time.Duration { return time.Duration(attempt) * time.Second }
+	tros.Equal(3*time.Second, opts.backoff(3))
+}
+
+func TestTxRetryOptionsSuite(t *testing.T) {
+	suite.Run(t, new(txRetryOptionsSuite))
+}
@@ -0,0 +1,175 @@
+package goqu_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/internal/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+var errRetryable = errors.New("retryable error")
+
+type txRetrySuite struct {
+	suite.Suite
+}
+
+func (trs *txRetrySuite) SetupSuite() {
+	opts := goqu.DefaultDialectOptions()
+	opts.IsRetryableError = func(err error) bool {
+		return err == errRetryable
+	}
+	goqu.RegisterDialect("retry-mock", opts)
+}
+
+func (trs *txRetrySuite) TearDownSuite() {
+	goqu.DeregisterDialect("retry-mock")
+}
+
+func (trs *txRetrySuite) noBackoff(attempts *[]int) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		*attempts = append(*attempts, attempt)
+		return 0
+	}
+}
+
+func (trs *txRetrySuite) TestWithTxRetry() {
+	mDB, mock, err := sqlmock.New()
+	trs.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "items" SET "name"='Test'`).WillReturnError(errRetryable)
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "items" SET "name"='Test'`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit().WillReturnError(errRetryable)
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "items" SET "name"='Test'`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	var backoffs []int
+	calls := 0
+	db := goqu.New("retry-mock", mDB)
+	trs.NoError(db.WithTxRetry(context.Background(), goqu.TxRetryOptions{Backoff: trs.noBackoff(&backoffs)},
+		func(tx *goqu.TxDatabase) error {
+			calls++
+			_, execErr := tx.Update("items").Set(goqu.Record{"name": "Test"}).Executor().Exec()
+			return execErr
+		},
+	))
+	trs.Equal(3, calls)
+	trs.Equal([]int{1, 2}, backoffs)
+	trs.NoError(mock.ExpectationsWereMet())
+}
+
+func (trs *txRetrySuite) TestWithTxRetry_MaxAttempts() {
+	mDB, mock, err := sqlmock.New()
+	trs.NoError(err)
+	for i := 0; i < 2; i++ {
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+	}
+
+	var backoffs []int
+	calls := 0
+	db := goqu.New("retry-mock", mDB)
+	opts := goqu.TxRetryOptions{MaxAttempts: 2, Backoff: trs.noBackoff(&backoffs)}
+	trs.Equal(errRetryable, db.WithTxRetry(context.Background(), opts, func(tx *goqu.TxDatabase) error {
+		calls++
+		return errRetryable
+	}))
+	trs.Equal(2, calls)
+	trs.Equal([]int{1}, backoffs)
+	trs.NoError(mock.ExpectationsWereMet())
+}
+
+func (trs *txRetrySuite) TestWithTxRetry_NotRetryable() {
+	mDB, mock, err := sqlmock.New()
+	trs.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	calls := 0
+	fn := func(tx *goqu.TxDatabase) error {
+		calls++
+		return errRetryable
+	}
+	// the error is not retryable according to the dialect
+	trs.EqualError(goqu.New("retry-mock", mDB).WithTxRetry(context.Background(), goqu.TxRetryOptions{},
+		func(tx *goqu.TxDatabase) error {
+			calls++
+			return errors.New("not retryable")
+		},
+	), "goqu: not retryable")
+	// the dialect does not register a classifier
+	trs.Equal(errRetryable, goqu.New("mock", mDB).WithTxRetry(context.Background(), goqu.TxRetryOptions{}, fn))
+	trs.Equal(2, calls)
+	trs.NoError(mock.ExpectationsWereMet())
+}
+
+func (trs *txRetrySuite) TestWithTxRetry_IsRetryable() {
+	mDB, mock, err := sqlmock.New()
+	trs.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	var backoffs []int
+	retryErr := errors.New("custom retryable")
+	calls := 0
+	opts := goqu.TxRetryOptions{
+		Backoff:     trs.noBackoff(&backoffs),
+		IsRetryable: func(err error) bool { return err == retryErr },
+	}
+	trs.NoError(goqu.New("mock", mDB).WithTxRetry(context.Background(), opts, func(tx *goqu.TxDatabase) error {
+		calls++
+		if calls == 1 {
+			return retryErr
+		}
+		return nil
+	}))
+	trs.Equal(2, calls)
+	trs.NoError(mock.ExpectationsWereMet())
+}
+
+func (trs *txRetrySuite) TestWithTxRetry_ContextDone() {
+	mDB, mock, err := sqlmock.New()
+	trs.NoError(err)
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	opts := goqu.TxRetryOptions{InitialBackoff: time.Hour}
+	trs.Equal(context.Canceled, goqu.New("retry-mock", mDB).WithTxRetry(ctx, opts, func(tx *goqu.TxDatabase) error {
+		calls++
+		cancel()
+		return errRetryable
+	}))
+	trs.Equal(1, calls)
+	trs.NoError(mock.ExpectationsWereMet())
+}
+
+func (trs *txRetrySuite) TestWithTxRetry_BeginError() {
+	mDB, mock, err := sqlmock.New()
+	trs.NoError(err)
+	mock.ExpectBegin().WillReturnError(errRetryable)
+	mock.ExpectBegin().WillReturnError(errors.New("begin error"))
+
+	var backoffs []int
+	opts := goqu.TxRetryOptions{Backoff: trs.noBackoff(&backoffs)}
+	trs.EqualError(goqu.New("retry-mock", mDB).WithTxRetry(context.Background(), opts, func(tx *goqu.TxDatabase) error {
+		return nil
+	}), "goqu: begin error")
+	trs.Equal([]int{1}, backoffs)
+	trs.NoError(mock.ExpectationsWereMet())
+}
+
+func TestTxRetrySuite(t *testing.T) {
+	suite.Run(t, new(txRetrySuite))
+}
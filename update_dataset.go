@@ -233,7 +233,8 @@ func (ud *UpdateDataset) ReturnsColumns() bool {
 //
 //	db.Update("test").Set(Record{"name":"Bob", update: time.Now()}).Executor()
 func (ud *UpdateDataset) Executor() exec.QueryExecutor {
-	return ud.queryFactory.FromSQLBuilder(ud.updateSQLBuilder())
+	return ud.queryFactory.FromSQLBuilder(ud.updateSQLBuilder()).WithOperation(exec.OperationUpdate).
+		WithColumnConverter(ud.dialect.DialectOptions().ConvertColumnValue)
 }
 
 func (ud *UpdateDataset) updateSQLBuilder() sb.SQLBuilder {